@@ -0,0 +1,109 @@
+package length
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// An Area represents a surface area as a float64 square nanometer count.
+// The representation limits the largest representable area to
+// approximately 1.8e290 square meters.
+type Area float64
+
+// A Volume represents a volume as a float64 cubic nanometer count.
+// The representation limits the largest representable volume to
+// approximately 1.8e281 cubic meters.
+type Volume float64
+
+// Common areas that are not the square of a distance unit.
+const (
+	Hectare Area = 1e4 * Area(Meter) * Area(Meter)
+	Acre    Area = 4046.8564224 * Area(Meter) * Area(Meter)
+)
+
+// MulDistance returns the area of a rectangle with sides d and o.
+// Since an Area counts square nanometers, sides longer than about
+// 1.3e145 meters overflow the product to +Inf or -Inf.
+func (d Distance) MulDistance(o Distance) Area {
+	return Area(d) * Area(o)
+}
+
+// SquareMeters returns the area as a floating point number of square meters.
+func (a Area) SquareMeters() float64 {
+	return float64(a / (Area(Meter) * Area(Meter)))
+}
+
+// SquareFeet returns the area as a floating point number of square feet.
+func (a Area) SquareFeet() float64 {
+	return float64(a / (Area(Feet) * Area(Feet)))
+}
+
+// Acres returns the area as a floating point number of acres.
+func (a Area) Acres() float64 {
+	return float64(a / Acre)
+}
+
+// Hectares returns the area as a floating point number of hectares.
+func (a Area) Hectares() float64 {
+	return float64(a / Hectare)
+}
+
+// String returns the area in square meters using the fewest digits
+// that ParseArea reads back as the same value, such as "30m²".
+func (a Area) String() string {
+	return strconv.FormatFloat(a.SquareMeters(), 'g', -1, 64) + "m²"
+}
+
+// areaUnits holds the area units accepted by ParseArea that are not
+// written as a squared distance unit.
+var areaUnits = map[string]Area{
+	"ha":    Hectare,
+	"ac":    Acre,
+	"acre":  Acre,
+	"acres": Acre,
+}
+
+// ParseArea parses an area string, which is a signed decimal number
+// followed by a unit, such as "30m2", "1.5acre" or "-2e3ft²".
+// Valid units are any distance unit accepted by ParseDistance followed
+// by "2" or "²", "ha", and "ac", "acre" or "acres".
+// Errors are of type *ParseError, as for ParseDistance.
+func ParseArea(s string) (Area, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	rem := s
+	if rem != "" && (rem[0] == '-' || rem[0] == '+') {
+		rem = rem[1:]
+	}
+	rem = leadingDigits(rem, false)
+	if rem != "" && rem[0] == '.' {
+		rem = leadingDigits(rem[1:], false)
+	}
+	rem = leadingExponent(rem)
+	num := s[:len(s)-len(rem)]
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) && v != 0 {
+			return 0, &ParseError{Input: orig, Part: num, Err: ErrOverflow, noun: "area"}
+		}
+		return 0, &ParseError{Input: orig, Part: num, Err: ErrInvalidDistance, noun: "area"}
+	}
+	u := strings.TrimSpace(rem)
+	if u == "" {
+		return 0, &ParseError{Input: orig, Part: num, Err: ErrMissingUnit, noun: "area"}
+	}
+	unit, ok := areaUnits[u]
+	if !ok {
+		base, squared := strings.CutSuffix(u, "2")
+		if !squared {
+			base, squared = strings.CutSuffix(u, "²")
+		}
+		side, known := unitMap[base]
+		if !squared || !known {
+			return 0, &ParseError{Input: orig, Part: u, Err: ErrUnknownUnit, noun: "area"}
+		}
+		unit = Area(side) * Area(side)
+	}
+	return Area(v) * unit, nil
+}
@@ -0,0 +1,143 @@
+package length
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDistance_MulDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		d, o Distance
+		want float64 // square meters
+	}{
+		{name: "Room", d: 5 * Meter, o: 4 * Meter, want: 20},
+		{name: "Mixed Units", d: 10 * Feet, o: 2 * Meter, want: 10 * 0.3048 * 2},
+		{name: "Negative Side", d: -3 * Meter, o: 2 * Meter, want: -6},
+		{name: "Zero", d: 0, o: Kilometer, want: 0},
+		{name: "Astronomical Overflows", d: Parsec * 1e130, o: Parsec * 1e130, want: math.Inf(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.MulDistance(tt.o).SquareMeters()
+			if got != tt.want && math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Distance.MulDistance() = %vm², want %vm²", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArea_Units(t *testing.T) {
+	a := Acre
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "Square Meters", got: a.SquareMeters(), want: 4046.8564224},
+		{name: "Square Feet", got: a.SquareFeet(), want: 43560},
+		{name: "Acres", got: a.Acres(), want: 1},
+		{name: "Hectares", got: a.Hectares(), want: 0.40468564224},
+		{name: "Hectare In Square Meters", got: Hectare.SquareMeters(), want: 10000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-9 {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArea_String(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Area
+		want string
+	}{
+		{name: "Room", a: (5 * Meter).MulDistance(6 * Meter), want: "30m²"},
+		{name: "Fraction", a: (Meter / 2).MulDistance(Meter), want: "0.5m²"},
+		{name: "Hectare", a: Hectare, want: "10000m²"},
+		{name: "Zero", a: 0, want: "0m²"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.String(); got != tt.want {
+				t.Errorf("Area.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseArea(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Area
+		wantErr error
+	}{
+		{name: "Square Meters", s: "30m2", want: (5 * Meter).MulDistance(6 * Meter)},
+		{name: "Superscript Two", s: "30m²", want: (5 * Meter).MulDistance(6 * Meter)},
+		{name: "Square Feet", s: "100ft2", want: (10 * Feet).MulDistance(10 * Feet)},
+		{name: "Square Kilometers", s: "1.5km2", want: (1500 * Meter).MulDistance(Kilometer)},
+		{name: "Acre", s: "1acre", want: Acre},
+		{name: "Acres", s: "2.5acres", want: 2.5 * Acre},
+		{name: "Acre Abbreviation", s: "4ac", want: 4 * Acre},
+		{name: "Hectares", s: "3ha", want: 3 * Hectare},
+		{name: "Negative With Exponent", s: "-2e3m2", want: -2000 * Area(Meter) * Area(Meter)},
+		{name: "Space Before Unit", s: " 12 m2 ", want: 12 * Area(Meter) * Area(Meter)},
+		{name: "Leading Decimal", s: ".5ha", want: Hectare / 2},
+		{name: "Missing Unit", s: "30", wantErr: ErrMissingUnit},
+		{name: "Unsquared Unit", s: "30m", wantErr: ErrUnknownUnit},
+		{name: "Unknown Unit", s: "30furlong2", wantErr: ErrUnknownUnit},
+		{name: "Missing Number", s: "m2", wantErr: ErrInvalidDistance},
+		{name: "Empty", s: "", wantErr: ErrInvalidDistance},
+		{name: "Overflow", s: "1e999m2", wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseArea(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseArea() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var perr *ParseError
+			if err != nil && !errors.As(err, &perr) {
+				t.Errorf("ParseArea() error = %T, want *ParseError", err)
+			}
+			if got != tt.want && math.Abs(float64(got-tt.want)) > 1e-9*math.Abs(float64(tt.want)) {
+				t.Errorf("ParseArea() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseArea_ErrorText(t *testing.T) {
+	_, err := ParseArea("30furlong2")
+	if want := "length: unknown unit furlong2 in area 30furlong2"; err == nil || err.Error() != want {
+		t.Errorf("ParseArea() error = %v, want %q", err, want)
+	}
+}
+
+func TestArea_StringRoundTrip(t *testing.T) {
+	areas := []Area{
+		0,
+		Acre,
+		Hectare,
+		(Mile).MulDistance(Mile),
+		(3 * Inch).MulDistance(7 * Inch),
+		(Nanometer).MulDistance(Nanometer),
+		-(2.5 * Meter).MulDistance(Meter),
+	}
+	for _, a := range areas {
+		got, err := ParseArea(a.String())
+		if err != nil {
+			t.Errorf("ParseArea(%q) error = %v", a.String(), err)
+			continue
+		}
+		if got != a && math.Abs(float64(got-a)) > 1e-12*math.Abs(float64(a)) {
+			t.Errorf("ParseArea(%q) = %v, want %v", a.String(), got, a)
+		}
+	}
+}
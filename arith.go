@@ -0,0 +1,431 @@
+package length
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"sync/atomic"
+)
+
+// SplitAt cuts a path of length total at the distance at from its start,
+// returning the lengths of the two pieces: before is at and after is total-at.
+// An error is returned if at is negative or exceeds total.
+func SplitAt(total, at Distance) (before, after Distance, err error) {
+	if at < 0 || at > total {
+		return 0, 0, errors.New("length: split point " + at.String() + " outside of " + total.String())
+	}
+	return at, total - at, nil
+}
+
+// Reflect returns the reflection of d across the point about,
+// that is 2*about - d. It is computed as about + (about - d)
+// so that large distances do not overflow needlessly.
+func (d Distance) Reflect(about Distance) Distance {
+	return about + (about - d)
+}
+
+// multipleTolerance is how far d/step may be from a whole number for d
+// to still count as a multiple of step. It is relative to the quotient,
+// or absolute when the quotient is smaller than one.
+const multipleTolerance = 1e-9
+
+// IsMultipleOf reports whether d is a whole multiple of step.
+// To allow for floating point round-off, such as 0.3m not being an exact
+// multiple of 0.1m, d/step may differ from a whole number by one part in
+// a billion (1e-9 of the quotient, or 1e-9 if the quotient is less than one).
+// Every distance is a multiple of itself, and only zero is a multiple of zero.
+func (d Distance) IsMultipleOf(step Distance) bool {
+	if step == 0 {
+		return d == 0
+	}
+	q := float64(d / step)
+	if math.IsInf(q, 0) || math.IsNaN(q) {
+		return false
+	}
+	return math.Abs(q-math.Round(q)) <= multipleTolerance*math.Max(1, math.Abs(q))
+}
+
+// MaxFromChan receives distances from ch until it is closed and returns
+// the largest one. The boolean is false if ch was closed without
+// delivering any distances.
+func MaxFromChan(ch <-chan Distance) (Distance, bool) {
+	max, ok := <-ch
+	if !ok {
+		return 0, false
+	}
+	for d := range ch {
+		if d > max {
+			max = d
+		}
+	}
+	return max, true
+}
+
+// MinFromChan receives distances from ch until it is closed and returns
+// the smallest one. The boolean is false if ch was closed without
+// delivering any distances.
+func MinFromChan(ch <-chan Distance) (Distance, bool) {
+	min, ok := <-ch
+	if !ok {
+		return 0, false
+	}
+	for d := range ch {
+		if d < min {
+			min = d
+		}
+	}
+	return min, true
+}
+
+// Min returns the smallest of its arguments, so that Min(a, b) is the
+// shorter of two distances. As with the built-in min, if any argument
+// is NaN the result is NaN.
+func Min(a Distance, rest ...Distance) Distance {
+	for _, d := range rest {
+		a = min(a, d)
+	}
+	return a
+}
+
+// Max returns the largest of its arguments, so that Max(a, b) is the
+// longer of two distances. As with the built-in max, if any argument
+// is NaN the result is NaN.
+func Max(a Distance, rest ...Distance) Distance {
+	for _, d := range rest {
+		a = max(a, d)
+	}
+	return a
+}
+
+// Clamp returns d limited to the range [lo, hi]: lo if d is less than lo,
+// hi if d is greater than hi, and d otherwise. If lo is greater than hi
+// the bounds are swapped, so the order they are given in does not matter.
+// If d, lo or hi is NaN, Clamp returns NaN.
+func (d Distance) Clamp(lo, hi Distance) Distance {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return min(max(d, lo), hi)
+}
+
+// Between reports whether d lies in the closed range [lo, hi],
+// that is lo <= d <= hi. Unlike Clamp, Between does not swap an
+// inverted range: if lo is greater than hi it reports false.
+// It also reports false if d, lo or hi is NaN.
+func (d Distance) Between(lo, hi Distance) bool {
+	return lo <= d && d <= hi
+}
+
+// BetweenExclusive is like Between but for the open range (lo, hi),
+// so that it reports false when d equals either bound.
+func (d Distance) BetweenExclusive(lo, hi Distance) bool {
+	return lo < d && d < hi
+}
+
+// Wrap returns d wrapped onto a loop of the given circumference,
+// that is d modulo circumference in the range [0, circumference).
+// Negative distances wrap around from the end of the loop,
+// so -1m on a 10m loop is 9m.
+// If circumference is not positive, d is returned unchanged.
+func (d Distance) Wrap(circumference Distance) Distance {
+	if circumference <= 0 {
+		return d
+	}
+	r := Distance(math.Mod(float64(d), float64(circumference)))
+	if r < 0 {
+		r += circumference
+	}
+	// Adding the circumference to a tiny negative remainder may round up to it.
+	if r == circumference {
+		return 0
+	}
+	return r
+}
+
+// CyclicDistance returns the length of the shorter of the two arcs
+// between the points a and b on a loop of the given circumference.
+// The result lies in [0, circumference/2]: it is zero when a and b
+// coincide and half the circumference when they are antipodal.
+// If circumference is not positive, the absolute difference of a and b is returned.
+func CyclicDistance(a, b, circumference Distance) Distance {
+	if circumference <= 0 {
+		return Distance(math.Abs(float64(b - a)))
+	}
+	diff := (b - a).Wrap(circumference)
+	if other := circumference - diff; other < diff {
+		return other
+	}
+	return diff
+}
+
+// CompareTo compares d and o, returning cmp as -1, 0 or +1 when d is
+// shorter than, equal to or longer than o, along with the ratio d/o
+// (so a ratio of 1.5 means d is one and a half times as long as o).
+// When o is zero the ratio follows floating point division:
+// +Inf or -Inf depending on the sign of d, or NaN if d is also zero.
+func (d Distance) CompareTo(o Distance) (cmp int, ratio float64) {
+	switch {
+	case d < o:
+		cmp = -1
+	case d > o:
+		cmp = +1
+	}
+	return cmp, float64(d) / float64(o)
+}
+
+// Cmp compares d and o, returning -1, 0 or +1 when d is shorter than,
+// equal to or longer than o. It is CompareTo without the ratio, for use
+// with slices.SortFunc and similar.
+func (d Distance) Cmp(o Distance) int {
+	cmp, _ := d.CompareTo(o)
+	return cmp
+}
+
+// Less reports whether d is shorter than o.
+func (d Distance) Less(o Distance) bool {
+	return d < o
+}
+
+// Equal reports whether d and o are equal. If a tolerance is given,
+// they are equal if they differ by no more than it, which allows for
+// rounding, such as 0.1in+0.2in against 0.3in; only the first tolerance
+// is used. See Eq for a tolerance set once for the whole program.
+func (d Distance) Equal(o Distance, tolerance ...Distance) bool {
+	if d == o {
+		return true
+	}
+	if len(tolerance) == 0 {
+		return false
+	}
+	return math.Abs(float64(d-o)) <= math.Abs(float64(tolerance[0]))
+}
+
+// Distances attaches the methods of sort.Interface to []Distance,
+// sorting in increasing order.
+type Distances []Distance
+
+func (x Distances) Len() int           { return len(x) }
+func (x Distances) Less(i, j int) bool { return x[i] < x[j] }
+func (x Distances) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+// SortDistances sorts a slice of distances in increasing order.
+func SortDistances(ds []Distance) {
+	sort.Sort(Distances(ds))
+}
+
+// InterpolateTable looks up x in a table mapping the keys to the distances
+// dists, such as a calibration table from servo positions to extensions,
+// and returns the distance linearly interpolated between the two keys
+// bracketing x. Values of x outside the table are clamped to its first or
+// last distance. An error is returned if the table is empty, if keys and
+// dists differ in length, if the keys are not strictly increasing,
+// or if x is NaN.
+func InterpolateTable(keys []float64, dists []Distance, x float64) (Distance, error) {
+	if len(keys) == 0 {
+		return 0, errors.New("length: empty interpolation table")
+	}
+	if len(keys) != len(dists) {
+		return 0, errors.New("length: interpolation table has " + strconv.Itoa(len(keys)) +
+			" keys but " + strconv.Itoa(len(dists)) + " distances")
+	}
+	for i := 1; i < len(keys); i++ {
+		if !(keys[i-1] < keys[i]) {
+			return 0, errors.New("length: interpolation table keys are not strictly increasing")
+		}
+	}
+	if math.IsNaN(x) {
+		return 0, errors.New("length: NaN interpolation table key")
+	}
+	if x <= keys[0] {
+		return dists[0], nil
+	}
+	if x >= keys[len(keys)-1] {
+		return dists[len(dists)-1], nil
+	}
+	i := sort.SearchFloat64s(keys, x)
+	if keys[i] == x {
+		return dists[i], nil
+	}
+	t := (x - keys[i-1]) / (keys[i] - keys[i-1])
+	return dists[i-1] + Distance(t)*(dists[i]-dists[i-1]), nil
+}
+
+// Dedup returns the distances ds with runs of nearly equal consecutive
+// distances collapsed into the first distance of each run, such as
+// duplicated waypoints in an imported coordinate list.
+// A distance belongs to the current run if it is within tol of the
+// distance that started the run, so a slow drift still starts new runs.
+// Only adjacent distances are collapsed; equal distances that are
+// separated by a different one are all kept. The order of ds is preserved
+// and ds itself is not modified.
+func Dedup(ds []Distance, tol Distance) []Distance {
+	if len(ds) == 0 {
+		return nil
+	}
+	out := []Distance{ds[0]}
+	for _, d := range ds[1:] {
+		if math.Abs(float64(d-out[len(out)-1])) > float64(tol) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// comparisonTolerance holds the bits of the float64 nanometer count
+// used by Eq. It is atomic so that SetComparisonTolerance may be called
+// while other goroutines compare distances.
+var comparisonTolerance atomic.Uint64
+
+func init() {
+	SetComparisonTolerance(Nanometer)
+}
+
+// SetComparisonTolerance sets how far apart two distances may be for Eq
+// to report them equal, so that an application can choose the policy for
+// floating-point comparisons in one place. The sign of tol is ignored.
+// The default tolerance is one nanometer. It is safe to call
+// SetComparisonTolerance concurrently with Eq.
+func SetComparisonTolerance(tol Distance) {
+	comparisonTolerance.Store(math.Float64bits(math.Abs(float64(tol))))
+}
+
+// Eq reports whether d and o differ by no more than the tolerance set by
+// SetComparisonTolerance. Equal infinities are equal, and NaN is not
+// equal to anything.
+func (d Distance) Eq(o Distance) bool {
+	if d == o {
+		return true
+	}
+	tol := math.Float64frombits(comparisonTolerance.Load())
+	return math.Abs(float64(d-o)) <= tol
+}
+
+// Round returns the result of rounding d to the nearest multiple of unit.
+// The rounding behavior for halfway values is to round away from zero,
+// so negative distances round symmetrically to positive ones.
+// If unit <= 0, Round returns d unchanged.
+func (d Distance) Round(unit Distance) Distance {
+	if unit <= 0 {
+		return d
+	}
+	return Distance(math.Round(float64(d/unit))) * unit
+}
+
+// Truncate returns the result of rounding d toward zero to a multiple
+// of unit. To allow for floating point round-off, a distance that
+// IsMultipleOf unit is left at that multiple rather than truncated
+// to the one below it, so 0.3m truncates to 0.3m in units of 0.1m.
+// If unit <= 0, Truncate returns d unchanged.
+func (d Distance) Truncate(unit Distance) Distance {
+	if unit <= 0 {
+		return d
+	}
+	q := float64(d / unit)
+	if r := math.Round(q); math.Abs(q-r) <= multipleTolerance*math.Max(1, math.Abs(q)) {
+		q = r
+	}
+	return Distance(math.Trunc(q)) * unit
+}
+
+// Add returns the distance d+o. It is the same as d + o and, like float64
+// addition, overflows to +Inf or -Inf rather than wrapping around.
+func (d Distance) Add(o Distance) Distance {
+	return d + o
+}
+
+// Sub returns the distance d-o. It is the same as d - o and, like float64
+// subtraction, overflows to +Inf or -Inf rather than wrapping around.
+func (d Distance) Sub(o Distance) Distance {
+	return d - o
+}
+
+// Scale returns the distance d multiplied by factor. Like float64
+// multiplication, it overflows to +Inf or -Inf rather than wrapping around,
+// and scaling an infinite distance by zero gives NaN.
+func (d Distance) Scale(factor float64) Distance {
+	return Distance(float64(d) * factor)
+}
+
+// Abs returns the magnitude of d. Since a Distance is a float64, negating
+// the most negative distance, -math.MaxFloat64 nanometers, cannot
+// overflow: its magnitude is math.MaxFloat64 nanometers. Abs(-Inf) is +Inf,
+// and Abs(NaN) is NaN.
+func (d Distance) Abs() Distance {
+	return Distance(math.Abs(float64(d)))
+}
+
+// Sign returns -1 if d is negative, +1 if d is positive, and 0 if d is zero
+// or NaN.
+func (d Distance) Sign() int {
+	switch {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	}
+	return 0
+}
+
+// In returns the distance as a number of the given unit, so that
+// d.In(Mile) is the mileage of d. The result may be fractional or negative.
+// If unit is zero, In returns +Inf or -Inf, with the sign of d,
+// or NaN if d is also zero.
+func (d Distance) In(unit Distance) float64 {
+	return float64(d) / float64(unit)
+}
+
+// CountOf returns the number of whole units in d, rounded to the nearest
+// integer, and whether d is an exact multiple of unit (within the
+// tolerance described in IsMultipleOf), so that callers storing d as
+// an integer count of unit know whether anything was lost.
+// If unit is zero or the count does not fit in an int64, CountOf
+// returns 0, false.
+func (d Distance) CountOf(unit Distance) (int64, bool) {
+	if unit == 0 {
+		return 0, false
+	}
+	n := math.Round(float64(d / unit))
+	if !(n >= math.MinInt64 && n < math.MaxInt64) {
+		return 0, false
+	}
+	return int64(n), d.IsMultipleOf(unit)
+}
+
+// Int64Nanometers returns the distance as a whole number of nanometers,
+// rounded to the nearest integer, for use in integer arithmetic.
+// An error is returned if the count does not fit in an int64,
+// which is the case for distances longer than about 9.2 million kilometers
+// (about a millionth of a light year), or if d is NaN.
+func (d Distance) Int64Nanometers() (int64, error) {
+	n := math.Round(float64(d))
+	if !(n >= math.MinInt64 && n < math.MaxInt64) {
+		return 0, errors.New("length: distance " + d.String() + " out of int64 nanometer range")
+	}
+	return int64(n), nil
+}
+
+// FromInt64Nanometers returns the distance of n nanometers.
+// It is the inverse of Int64Nanometers.
+func FromInt64Nanometers(n int64) Distance {
+	return Distance(n) * Nanometer
+}
+
+// SumWithLoss returns the sum of the distances ds, added in order, along
+// with the number of nonzero terms that were absorbed entirely by rounding:
+// terms smaller than half a unit in the last place of the running sum,
+// which leave it unchanged. Adding millimeters to a lightyear is such a case.
+// A nonzero lostTerms means the sum does not reflect every term and should
+// not be relied on for the smaller distances. Only terms absorbed as they
+// are added are counted: a running sum that is itself swamped by a much
+// larger term that follows it is not.
+func SumWithLoss(ds []Distance) (sum Distance, lostTerms int) {
+	for _, d := range ds {
+		next := sum + d
+		if next == sum && d != 0 {
+			lostTerms++
+		}
+		sum = next
+	}
+	return sum, lostTerms
+}
@@ -0,0 +1,1232 @@
+package length
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSplitAt(t *testing.T) {
+	type args struct {
+		total Distance
+		at    Distance
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantBefore Distance
+		wantAfter  Distance
+		wantErr    bool
+	}{
+		{
+			name:       "Valid Split",
+			args:       args{total: 5 * Kilometer, at: 2 * Kilometer},
+			wantBefore: 2 * Kilometer,
+			wantAfter:  3 * Kilometer,
+			wantErr:    false,
+		},
+		{
+			name:       "At Start",
+			args:       args{total: 5 * Kilometer, at: 0},
+			wantBefore: 0,
+			wantAfter:  5 * Kilometer,
+			wantErr:    false,
+		},
+		{
+			name:       "At End",
+			args:       args{total: 5 * Kilometer, at: 5 * Kilometer},
+			wantBefore: 5 * Kilometer,
+			wantAfter:  0,
+			wantErr:    false,
+		},
+		{
+			name:    "Negative",
+			args:    args{total: 5 * Kilometer, at: -1 * Meter},
+			wantErr: true,
+		},
+		{
+			name:    "Past End",
+			args:    args{total: 5 * Kilometer, at: 6 * Kilometer},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBefore, gotAfter, err := SplitAt(tt.args.total, tt.args.at)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitAt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotBefore != tt.wantBefore {
+				t.Errorf("SplitAt() before = %v, want %v", gotBefore, tt.wantBefore)
+			}
+			if gotAfter != tt.wantAfter {
+				t.Errorf("SplitAt() after = %v, want %v", gotAfter, tt.wantAfter)
+			}
+		})
+	}
+}
+
+func TestDistance_Reflect(t *testing.T) {
+	type args struct {
+		about Distance
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want Distance
+	}{
+		{
+			name: "Across Zero",
+			d:    3 * Meter,
+			args: args{about: 0},
+			want: -3 * Meter,
+		},
+		{
+			name: "Across Reference",
+			d:    3 * Meter,
+			args: args{about: 5 * Meter},
+			want: 7 * Meter,
+		},
+		{
+			name: "Across Itself",
+			d:    5 * Meter,
+			args: args{about: 5 * Meter},
+			want: 5 * Meter,
+		},
+		{
+			name: "Large Distances",
+			d:    Distance(math.MaxFloat64),
+			args: args{about: Distance(math.MaxFloat64)},
+			want: Distance(math.MaxFloat64),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Reflect(tt.args.about); got != tt.want {
+				t.Errorf("Distance.Reflect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_IsMultipleOf(t *testing.T) {
+	type args struct {
+		step Distance
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want bool
+	}{
+		{
+			name: "Exact Multiple",
+			d:    3 * Meter,
+			args: args{step: Meter},
+			want: true,
+		},
+		{
+			name: "Exact Imperial Multiple",
+			d:    5 * Feet,
+			args: args{step: Inch},
+			want: true,
+		},
+		{
+			name: "Negative Multiple",
+			d:    -4 * Centimeter,
+			args: args{step: 2 * Centimeter},
+			want: true,
+		},
+		{
+			name: "Zero",
+			d:    0,
+			args: args{step: Meter},
+			want: true,
+		},
+		{
+			name: "Round-off",
+			d:    0.3 * Meter,
+			args: args{step: 0.1 * Meter},
+			want: true,
+		},
+		{
+			name: "Near Miss Within Tolerance",
+			d:    Meter + 1e-4*Nanometer,
+			args: args{step: Meter},
+			want: true,
+		},
+		{
+			name: "Near Miss Outside Tolerance",
+			d:    Meter + Nanometer,
+			args: args{step: Meter},
+			want: false,
+		},
+		{
+			name: "Not A Multiple",
+			d:    1.5 * Meter,
+			args: args{step: Meter},
+			want: false,
+		},
+		{
+			name: "Smaller Than Step",
+			d:    Millimeter,
+			args: args{step: Meter},
+			want: false,
+		},
+		{
+			name: "Zero Step",
+			d:    Meter,
+			args: args{step: 0},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.IsMultipleOf(tt.args.step); got != tt.want {
+				t.Errorf("Distance.IsMultipleOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxFromChan(t *testing.T) {
+	tests := []struct {
+		name   string
+		ds     []Distance
+		want   Distance
+		wantOk bool
+	}{
+		{
+			name:   "Several",
+			ds:     []Distance{3 * Meter, -1 * Kilometer, 2 * Mile, 12 * Inch},
+			want:   2 * Mile,
+			wantOk: true,
+		},
+		{
+			name:   "One",
+			ds:     []Distance{-3 * Meter},
+			want:   -3 * Meter,
+			wantOk: true,
+		},
+		{
+			name:   "Empty",
+			ds:     nil,
+			want:   0,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := MaxFromChan(feed(tt.ds))
+			if got != tt.want || gotOk != tt.wantOk {
+				t.Errorf("MaxFromChan() = %v, %v, want %v, %v", got, gotOk, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestMinFromChan(t *testing.T) {
+	tests := []struct {
+		name   string
+		ds     []Distance
+		want   Distance
+		wantOk bool
+	}{
+		{
+			name:   "Several",
+			ds:     []Distance{3 * Meter, -1 * Kilometer, 2 * Mile, 12 * Inch},
+			want:   -1 * Kilometer,
+			wantOk: true,
+		},
+		{
+			name:   "One",
+			ds:     []Distance{3 * Meter},
+			want:   3 * Meter,
+			wantOk: true,
+		},
+		{
+			name:   "Empty",
+			ds:     nil,
+			want:   0,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := MinFromChan(feed(tt.ds))
+			if got != tt.want || gotOk != tt.wantOk {
+				t.Errorf("MinFromChan() = %v, %v, want %v, %v", got, gotOk, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestMin(t *testing.T) {
+	nan := Distance(math.NaN())
+	tests := []struct {
+		name string
+		a    Distance
+		rest []Distance
+		want Distance
+	}{
+		{name: "Two", a: 3 * Meter, rest: []Distance{Yard}, want: Yard},
+		{name: "Two Reversed", a: Yard, rest: []Distance{3 * Meter}, want: Yard},
+		{name: "One", a: Mile, want: Mile},
+		{name: "Several", a: 3 * Meter, rest: []Distance{-1 * Kilometer, 2 * Mile, 12 * Inch}, want: -1 * Kilometer},
+		{name: "Infinity", a: Meter, rest: []Distance{Distance(math.Inf(-1))}, want: Distance(math.Inf(-1))},
+		{name: "NaN", a: Meter, rest: []Distance{nan, -Meter}, want: nan},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Min(tt.a, tt.rest...)
+			if got != tt.want && !(math.IsNaN(float64(got)) && math.IsNaN(float64(tt.want))) {
+				t.Errorf("Min() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMax(t *testing.T) {
+	nan := Distance(math.NaN())
+	tests := []struct {
+		name string
+		a    Distance
+		rest []Distance
+		want Distance
+	}{
+		{name: "Two", a: 3 * Meter, rest: []Distance{Yard}, want: 3 * Meter},
+		{name: "Two Reversed", a: Yard, rest: []Distance{3 * Meter}, want: 3 * Meter},
+		{name: "One", a: Mile, want: Mile},
+		{name: "Several", a: 3 * Meter, rest: []Distance{-1 * Kilometer, 2 * Mile, 12 * Inch}, want: 2 * Mile},
+		{name: "Infinity", a: Meter, rest: []Distance{Distance(math.Inf(1))}, want: Distance(math.Inf(1))},
+		{name: "NaN", a: Meter, rest: []Distance{nan, -Meter}, want: nan},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Max(tt.a, tt.rest...)
+			if got != tt.want && !(math.IsNaN(float64(got)) && math.IsNaN(float64(tt.want))) {
+				t.Errorf("Max() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_Clamp(t *testing.T) {
+	nan := Distance(math.NaN())
+	type args struct {
+		lo Distance
+		hi Distance
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want Distance
+	}{
+		{name: "Inside", d: 5 * Meter, args: args{lo: 0, hi: 10 * Meter}, want: 5 * Meter},
+		{name: "Below", d: -5 * Meter, args: args{lo: 0, hi: 10 * Meter}, want: 0},
+		{name: "Above", d: Kilometer, args: args{lo: 0, hi: 10 * Meter}, want: 10 * Meter},
+		{name: "On Bound", d: 10 * Meter, args: args{lo: 0, hi: 10 * Meter}, want: 10 * Meter},
+		{name: "Inverted Range", d: Kilometer, args: args{lo: 10 * Meter, hi: 0}, want: 10 * Meter},
+		{name: "Inverted Range Below", d: -Meter, args: args{lo: 10 * Meter, hi: 0}, want: 0},
+		{name: "Empty Range", d: Mile, args: args{lo: Meter, hi: Meter}, want: Meter},
+		{name: "Infinite Bounds", d: Mile, args: args{lo: Distance(math.Inf(-1)), hi: Distance(math.Inf(1))}, want: Mile},
+		{name: "NaN Distance", d: nan, args: args{lo: 0, hi: Meter}, want: nan},
+		{name: "NaN Bound", d: Mile, args: args{lo: nan, hi: Meter}, want: nan},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.Clamp(tt.args.lo, tt.args.hi)
+			if got != tt.want && !(math.IsNaN(float64(got)) && math.IsNaN(float64(tt.want))) {
+				t.Errorf("Distance.Clamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_Between(t *testing.T) {
+	nan := Distance(math.NaN())
+	type args struct {
+		lo Distance
+		hi Distance
+	}
+	tests := []struct {
+		name          string
+		d             Distance
+		args          args
+		want          bool
+		wantExclusive bool
+	}{
+		{name: "Inside", d: 5 * Meter, args: args{lo: 0, hi: 10 * Meter}, want: true, wantExclusive: true},
+		{name: "Below", d: -5 * Meter, args: args{lo: 0, hi: 10 * Meter}, want: false, wantExclusive: false},
+		{name: "Above", d: Kilometer, args: args{lo: 0, hi: 10 * Meter}, want: false, wantExclusive: false},
+		{name: "Equal To Lower Bound", d: 0, args: args{lo: 0, hi: 10 * Meter}, want: true, wantExclusive: false},
+		{name: "Equal To Upper Bound", d: 10 * Meter, args: args{lo: 0, hi: 10 * Meter}, want: true, wantExclusive: false},
+		{name: "Single Point Range", d: Meter, args: args{lo: Meter, hi: Meter}, want: true, wantExclusive: false},
+		{name: "Negative Range", d: -2 * Meter, args: args{lo: -3 * Meter, hi: -Meter}, want: true, wantExclusive: true},
+		{name: "Outside Negative Range", d: 0, args: args{lo: -3 * Meter, hi: -Meter}, want: false, wantExclusive: false},
+		{name: "Inverted Range", d: 5 * Meter, args: args{lo: 10 * Meter, hi: 0}, want: false, wantExclusive: false},
+		{name: "Mixed Units", d: Yard, args: args{lo: 3 * Feet, hi: Meter}, want: true, wantExclusive: false},
+		{name: "NaN Distance", d: nan, args: args{lo: 0, hi: Meter}, want: false, wantExclusive: false},
+		{name: "NaN Bound", d: Meter, args: args{lo: nan, hi: Kilometer}, want: false, wantExclusive: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Between(tt.args.lo, tt.args.hi); got != tt.want {
+				t.Errorf("Distance.Between() = %v, want %v", got, tt.want)
+			}
+			if got := tt.d.BetweenExclusive(tt.args.lo, tt.args.hi); got != tt.wantExclusive {
+				t.Errorf("Distance.BetweenExclusive() = %v, want %v", got, tt.wantExclusive)
+			}
+		})
+	}
+}
+
+// feed returns a channel that delivers ds and is then closed.
+func feed(ds []Distance) <-chan Distance {
+	ch := make(chan Distance)
+	go func() {
+		for _, d := range ds {
+			ch <- d
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+func TestDistance_Wrap(t *testing.T) {
+	type args struct {
+		circumference Distance
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want Distance
+	}{
+		{
+			name: "Within",
+			d:    3 * Meter,
+			args: args{circumference: 10 * Meter},
+			want: 3 * Meter,
+		},
+		{
+			name: "Above",
+			d:    23 * Meter,
+			args: args{circumference: 10 * Meter},
+			want: 3 * Meter,
+		},
+		{
+			name: "Exactly Once Around",
+			d:    10 * Meter,
+			args: args{circumference: 10 * Meter},
+			want: 0,
+		},
+		{
+			name: "Below",
+			d:    -1 * Meter,
+			args: args{circumference: 10 * Meter},
+			want: 9 * Meter,
+		},
+		{
+			name: "Far Below",
+			d:    -21 * Meter,
+			args: args{circumference: 10 * Meter},
+			want: 9 * Meter,
+		},
+		{
+			name: "Zero Circumference",
+			d:    -21 * Meter,
+			args: args{circumference: 0},
+			want: -21 * Meter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Wrap(tt.args.circumference); got != tt.want {
+				t.Errorf("Distance.Wrap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCyclicDistance(t *testing.T) {
+	type args struct {
+		a             Distance
+		b             Distance
+		circumference Distance
+	}
+	tests := []struct {
+		name string
+		args args
+		want Distance
+	}{
+		{
+			name: "Same Point",
+			args: args{a: 3 * Meter, b: 13 * Meter, circumference: 10 * Meter},
+			want: 0,
+		},
+		{
+			name: "Adjacent",
+			args: args{a: 3 * Meter, b: 5 * Meter, circumference: 10 * Meter},
+			want: 2 * Meter,
+		},
+		{
+			name: "Adjacent Reversed",
+			args: args{a: 5 * Meter, b: 3 * Meter, circumference: 10 * Meter},
+			want: 2 * Meter,
+		},
+		{
+			name: "Antipodal",
+			args: args{a: 1 * Meter, b: 6 * Meter, circumference: 10 * Meter},
+			want: 5 * Meter,
+		},
+		{
+			name: "Wrap Around",
+			args: args{a: 9 * Meter, b: 1 * Meter, circumference: 10 * Meter},
+			want: 2 * Meter,
+		},
+		{
+			name: "Wrap Around Negative",
+			args: args{a: -1 * Meter, b: 8 * Meter, circumference: 10 * Meter},
+			want: 1 * Meter,
+		},
+		{
+			name: "Zero Circumference",
+			args: args{a: 9 * Meter, b: 1 * Meter, circumference: 0},
+			want: 8 * Meter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CyclicDistance(tt.args.a, tt.args.b, tt.args.circumference); got != tt.want {
+				t.Errorf("CyclicDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_CompareTo(t *testing.T) {
+	type args struct {
+		o Distance
+	}
+	tests := []struct {
+		name      string
+		d         Distance
+		args      args
+		wantCmp   int
+		wantRatio float64
+	}{
+		{
+			name:      "Larger",
+			d:         3 * Meter,
+			args:      args{o: 2 * Meter},
+			wantCmp:   1,
+			wantRatio: 1.5,
+		},
+		{
+			name:      "Smaller",
+			d:         Feet,
+			args:      args{o: Yard},
+			wantCmp:   -1,
+			wantRatio: 1.0 / 3,
+		},
+		{
+			name:      "Equal",
+			d:         Kilometer,
+			args:      args{o: 1000 * Meter},
+			wantCmp:   0,
+			wantRatio: 1,
+		},
+		{
+			name:      "Zero Numerator",
+			d:         0,
+			args:      args{o: Meter},
+			wantCmp:   -1,
+			wantRatio: 0,
+		},
+		{
+			name:      "Zero Denominator",
+			d:         Meter,
+			args:      args{o: 0},
+			wantCmp:   1,
+			wantRatio: math.Inf(1),
+		},
+		{
+			name:      "Negative Over Zero",
+			d:         -Meter,
+			args:      args{o: 0},
+			wantCmp:   -1,
+			wantRatio: math.Inf(-1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCmp, gotRatio := tt.d.CompareTo(tt.args.o)
+			if gotCmp != tt.wantCmp {
+				t.Errorf("Distance.CompareTo() cmp = %v, want %v", gotCmp, tt.wantCmp)
+			}
+			if gotRatio != tt.wantRatio {
+				t.Errorf("Distance.CompareTo() ratio = %v, want %v", gotRatio, tt.wantRatio)
+			}
+		})
+	}
+	if _, ratio := Distance(0).CompareTo(0); !math.IsNaN(ratio) {
+		t.Errorf("Distance.CompareTo() ratio = %v, want NaN", ratio)
+	}
+}
+
+func TestDistance_Cmp(t *testing.T) {
+	tests := []struct {
+		name     string
+		d, o     Distance
+		want     int
+		wantLess bool
+	}{
+		{name: "Longer", d: 11 * Inch, o: 25 * Centimeter, want: 1, wantLess: false},
+		{name: "Shorter", d: Inch, o: 3 * Centimeter, want: -1, wantLess: true},
+		{name: "Equal", d: 3 * Feet, o: Yard, want: 0, wantLess: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Cmp(tt.o); got != tt.want {
+				t.Errorf("Distance.Cmp() = %v, want %v", got, tt.want)
+			}
+			if got := tt.d.Less(tt.o); got != tt.wantLess {
+				t.Errorf("Distance.Less() = %v, want %v", got, tt.wantLess)
+			}
+		})
+	}
+}
+
+func TestDistance_Equal(t *testing.T) {
+	// Neither 0.1 nor 0.2 is exact in floating point.
+	a, b := Distance(0.1), Distance(0.2)
+	tests := []struct {
+		name      string
+		d, o      Distance
+		tolerance []Distance
+		want      bool
+	}{
+		{name: "Exact", d: Yard, o: 3 * Feet, want: true},
+		{name: "Rounding Without Tolerance", d: a + b, o: 0.3, want: false},
+		{name: "Rounding With Tolerance", d: a + b, o: 0.3, tolerance: []Distance{Nanometer}, want: true},
+		{name: "Outside Tolerance", d: Meter, o: Meter + Millimeter, tolerance: []Distance{Micrometer}, want: false},
+		{name: "Negative Tolerance", d: Meter, o: Meter + Millimeter, tolerance: []Distance{-Centimeter}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Equal(tt.o, tt.tolerance...); got != tt.want {
+				t.Errorf("Distance.Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortDistances(t *testing.T) {
+	ds := []Distance{Yard, Meter, 3 * Feet, -Inch, 2 * Centimeter, Mile, Kilometer}
+	SortDistances(ds)
+	want := []Distance{-Inch, 2 * Centimeter, Yard, 3 * Feet, Meter, Kilometer, Mile}
+	if !reflect.DeepEqual(ds, want) {
+		t.Errorf("SortDistances() = %v, want %v", ds, want)
+	}
+}
+
+func ExampleDistances() {
+	ds := []Distance{Mile, 2 * Centimeter, Kilometer, Yard}
+	sort.Sort(Distances(ds))
+	fmt.Println(ds[0].FormatTrimmed(Metric), ds[len(ds)-1].FormatTrimmed(Metric))
+	// Output: 2cm 1.609344km
+}
+
+func TestInterpolateTable(t *testing.T) {
+	keys := []float64{0, 10, 20}
+	dists := []Distance{0, 5 * Centimeter, 20 * Centimeter}
+	type args struct {
+		keys  []float64
+		dists []Distance
+		x     float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name:    "Interior",
+			args:    args{keys: keys, dists: dists, x: 5},
+			want:    2.5 * Centimeter,
+			wantErr: false,
+		},
+		{
+			name:    "Interior Second Segment",
+			args:    args{keys: keys, dists: dists, x: 15},
+			want:    12.5 * Centimeter,
+			wantErr: false,
+		},
+		{
+			name:    "Exact Key",
+			args:    args{keys: keys, dists: dists, x: 10},
+			want:    5 * Centimeter,
+			wantErr: false,
+		},
+		{
+			name:    "Below Range",
+			args:    args{keys: keys, dists: dists, x: -3},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name:    "Above Range",
+			args:    args{keys: keys, dists: dists, x: 30},
+			want:    20 * Centimeter,
+			wantErr: false,
+		},
+		{
+			name:    "Single Entry",
+			args:    args{keys: []float64{1}, dists: []Distance{Meter}, x: 7},
+			want:    Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Empty",
+			args:    args{keys: nil, dists: nil, x: 7},
+			wantErr: true,
+		},
+		{
+			name:    "Mismatched Lengths",
+			args:    args{keys: keys, dists: dists[:2], x: 5},
+			wantErr: true,
+		},
+		{
+			name:    "Unsorted Keys",
+			args:    args{keys: []float64{0, 20, 10}, dists: dists, x: 5},
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate Keys",
+			args:    args{keys: []float64{0, 10, 10}, dists: dists, x: 5},
+			wantErr: true,
+		},
+		{
+			name:    "NaN Key",
+			args:    args{keys: keys, dists: dists, x: math.NaN()},
+			wantErr: true,
+		},
+		{
+			name:    "Infinite Key",
+			args:    args{keys: keys, dists: dists, x: math.Inf(1)},
+			want:    20 * Centimeter,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InterpolateTable(tt.args.keys, tt.args.dists, tt.args.x)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InterpolateTable() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("InterpolateTable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedup(t *testing.T) {
+	type args struct {
+		ds  []Distance
+		tol Distance
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Distance
+	}{
+		{
+			name: "Runs Of Near Equal Values",
+			args: args{
+				ds:  []Distance{Meter, Meter + Millimeter, Meter - Millimeter, 2 * Meter, 2*Meter + Millimeter},
+				tol: Centimeter,
+			},
+			want: []Distance{Meter, 2 * Meter},
+		},
+		{
+			name: "Distinct Values",
+			args: args{
+				ds:  []Distance{Meter, 2 * Meter, Meter},
+				tol: Centimeter,
+			},
+			want: []Distance{Meter, 2 * Meter, Meter},
+		},
+		{
+			name: "Drift",
+			args: args{
+				ds:  []Distance{0, 6 * Millimeter, 12 * Millimeter, 18 * Millimeter},
+				tol: Centimeter,
+			},
+			want: []Distance{0, 12 * Millimeter},
+		},
+		{
+			name: "Zero Tolerance",
+			args: args{
+				ds:  []Distance{Meter, Meter, Meter + Nanometer},
+				tol: 0,
+			},
+			want: []Distance{Meter, Meter + Nanometer},
+		},
+		{
+			name: "Empty",
+			args: args{
+				ds:  nil,
+				tol: Centimeter,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Dedup(tt.args.ds, tt.args.tol); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Dedup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_Eq(t *testing.T) {
+	tests := []struct {
+		name string
+		tol  Distance
+		d, o Distance
+		want bool
+	}{
+		{name: "Default Equal", tol: Nanometer, d: 0.1*Meter + 0.2*Meter, o: 0.3 * Meter, want: true},
+		{name: "Default Within", tol: Nanometer, d: Meter, o: Meter + 0.5*Nanometer, want: true},
+		{name: "Default Outside", tol: Nanometer, d: Meter, o: Meter + 2*Nanometer, want: false},
+		{name: "Coarse", tol: Millimeter, d: Meter, o: Meter + 0.9*Millimeter, want: true},
+		{name: "Coarse Outside", tol: Millimeter, d: Meter, o: Meter - 1.1*Millimeter, want: false},
+		{name: "Negative Tolerance", tol: -Millimeter, d: Meter, o: Meter + 0.9*Millimeter, want: true},
+		{name: "Exact", tol: 0, d: Meter, o: Meter + Nanometer, want: false},
+		{name: "Infinity", tol: Nanometer, d: Distance(math.Inf(1)), o: Distance(math.Inf(1)), want: true},
+		{name: "NaN", tol: Meter, d: Distance(math.NaN()), o: Distance(math.NaN()), want: false},
+	}
+	defer SetComparisonTolerance(Nanometer)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetComparisonTolerance(tt.tol)
+			if got := tt.d.Eq(tt.o); got != tt.want {
+				t.Errorf("Distance.Eq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetComparisonTolerance_Concurrent(t *testing.T) {
+	defer SetComparisonTolerance(Nanometer)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				SetComparisonTolerance(Distance(j) * Nanometer)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if !Meter.Eq(Meter) {
+					t.Errorf("Distance.Eq() = false for identical distances")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDistance_Round(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		unit Distance
+		want Distance
+	}{
+		{name: "To Meter", d: 1.4 * Meter, unit: Meter, want: Meter},
+		{name: "To Centimeter", d: 1.4 * Meter, unit: Centimeter, want: 140 * Centimeter},
+		{name: "Half Away From Zero", d: 2.5 * Meter, unit: Meter, want: 3 * Meter},
+		{name: "Negative Half Away From Zero", d: -2.5 * Meter, unit: Meter, want: -3 * Meter},
+		{name: "Negative", d: -1.4 * Meter, unit: Meter, want: -Meter},
+		{name: "To Feet", d: 40 * Inch, unit: Feet, want: 3 * Feet},
+		{name: "Zero Unit", d: 1.4 * Meter, unit: 0, want: 1.4 * Meter},
+		{name: "Negative Unit", d: 1.4 * Meter, unit: -Meter, want: 1.4 * Meter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Round(tt.unit); got != tt.want {
+				t.Errorf("Distance.Round() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_Truncate(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		unit Distance
+		want Distance
+	}{
+		{name: "To Meter", d: 1.9 * Meter, unit: Meter, want: Meter},
+		{name: "To Centimeter", d: 1.4 * Meter, unit: Centimeter, want: 140 * Centimeter},
+		{name: "Negative Toward Zero", d: -1.9 * Meter, unit: Meter, want: -Meter},
+		{name: "Round Off", d: 0.3 * Meter, unit: 0.1 * Meter, want: 3 * (0.1 * Meter)},
+		{name: "To Feet", d: 47 * Inch, unit: Feet, want: 3 * Feet},
+		{name: "Zero Unit", d: 1.9 * Meter, unit: 0, want: 1.9 * Meter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Truncate(tt.unit); got != tt.want {
+				t.Errorf("Distance.Truncate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// 0.3 is not exactly three times 0.1 in floating point.
+	d, unit := Distance(0.3), Distance(0.1)
+	if got := d.Truncate(unit); got != 3*unit {
+		t.Errorf("Distance.Truncate() = %v, want %v", float64(got), float64(3*unit))
+	}
+}
+
+func TestDistance_AddSubScale(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Distance
+		want Distance
+	}{
+		{name: "Add", got: Meter.Add(50 * Centimeter), want: 1.5 * Meter},
+		{name: "Sub", got: Meter.Sub(3 * Meter), want: -2 * Meter},
+		{name: "Scale", got: Meter.Scale(2.5), want: 2.5 * Meter},
+		{name: "Chain", got: Kilometer.Sub(250 * Meter).Scale(2).Add(Centimeter), want: 1500.01 * Meter},
+		{name: "Add Overflow", got: Distance(math.MaxFloat64).Add(Distance(math.MaxFloat64)), want: Distance(math.Inf(1))},
+		{name: "Sub Overflow", got: Distance(-math.MaxFloat64).Sub(Distance(math.MaxFloat64)), want: Distance(math.Inf(-1))},
+		{name: "Scale Overflow", got: Distance(math.MaxFloat64).Scale(-2), want: Distance(math.Inf(-1))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want && math.Abs(float64(tt.got-tt.want)) > 1e-6 {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+	if got := Distance(math.Inf(1)).Scale(0); !math.IsNaN(float64(got)) {
+		t.Errorf("Distance.Scale() = %v, want NaN", got)
+	}
+}
+
+func TestDistance_AddAssociative(t *testing.T) {
+	ds := [][3]Distance{
+		{Meter, Centimeter, Nanometer},
+		{Mile, -Yard, 0.1 * Inch},
+		{Kilometer, 1.0 / 3 * Meter, 2.0 / 3 * Millimeter},
+	}
+	for _, c := range ds {
+		a, b, x := c[0], c[1], c[2]
+		left := a.Add(b).Add(x)
+		right := a.Add(b.Add(x))
+		if math.Abs(float64(left-right)) > 1e-9*math.Abs(float64(left)) {
+			t.Errorf("(%v + %v) + %v = %v, but %v + (%v + %v) = %v", a, b, x, left, a, b, x, right)
+		}
+		scaled := a.Add(b).Scale(3)
+		distributed := a.Scale(3).Add(b.Scale(3))
+		if math.Abs(float64(scaled-distributed)) > 1e-9*math.Abs(float64(scaled)) {
+			t.Errorf("(%v + %v) * 3 = %v, but %v * 3 + %v * 3 = %v", a, b, scaled, a, b, distributed)
+		}
+	}
+}
+
+func TestDistance_Abs(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		want Distance
+	}{
+		{name: "Positive", d: Meter, want: Meter},
+		{name: "Negative", d: -2 * Feet, want: 2 * Feet},
+		{name: "Zero", d: 0, want: 0},
+		{name: "Most Negative", d: -math.MaxFloat64, want: math.MaxFloat64},
+		{name: "Negative Infinity", d: Distance(math.Inf(-1)), want: Distance(math.Inf(1))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Abs(); got != tt.want {
+				t.Errorf("Distance.Abs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := Distance(math.NaN()).Abs(); !math.IsNaN(float64(got)) {
+		t.Errorf("Distance.Abs() = %v, want NaN", got)
+	}
+	if got := Distance(math.Copysign(0, -1)).Abs(); math.Signbit(float64(got)) {
+		t.Errorf("Distance.Abs() = -0, want 0")
+	}
+}
+
+func TestDistance_Sign(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		want int
+	}{
+		{name: "Positive", d: Nanometer, want: 1},
+		{name: "Negative", d: -Lightyear, want: -1},
+		{name: "Zero", d: 0, want: 0},
+		{name: "Negative Zero", d: Distance(math.Copysign(0, -1)), want: 0},
+		{name: "Infinity", d: Distance(math.Inf(1)), want: 1},
+		{name: "NaN", d: Distance(math.NaN()), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Sign(); got != tt.want {
+				t.Errorf("Distance.Sign() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_In(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		unit Distance
+		want float64
+	}{
+		{name: "Nanometer", d: 3 * Nanometer, unit: Nanometer, want: 3},
+		{name: "Micrometer", d: 1500 * Nanometer, unit: Micrometer, want: 1.5},
+		{name: "Millimeter", d: -2 * Centimeter, unit: Millimeter, want: -20},
+		{name: "Centimeter", d: Inch, unit: Centimeter, want: 2.54},
+		{name: "Meter", d: 2.5 * Kilometer, unit: Meter, want: 2500},
+		{name: "Kilometer", d: 250 * Meter, unit: Kilometer, want: 0.25},
+		{name: "Inch", d: Feet, unit: Inch, want: 12},
+		{name: "Feet", d: -Yard, unit: Feet, want: -3},
+		{name: "Yard", d: 4.5 * Feet, unit: Yard, want: 1.5},
+		{name: "Mile", d: 2640 * Feet, unit: Mile, want: 0.5},
+		{name: "Lightyear", d: 9.461e15 * Meter, unit: Lightyear, want: 1},
+		{name: "Zero Unit", d: Meter, unit: 0, want: math.Inf(1)},
+		{name: "Zero Unit Negative", d: -Meter, unit: 0, want: math.Inf(-1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.In(tt.unit)
+			if got != tt.want && math.Abs(got-tt.want) > 1e-12*math.Abs(tt.want) {
+				t.Errorf("Distance.In() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := Distance(0).In(0); !math.IsNaN(got) {
+		t.Errorf("Distance.In() = %v, want NaN", got)
+	}
+}
+
+func TestDistance_CountOf(t *testing.T) {
+	type args struct {
+		unit Distance
+	}
+	tests := []struct {
+		name      string
+		d         Distance
+		args      args
+		want      int64
+		wantExact bool
+	}{
+		{
+			name:      "Exact",
+			d:         1.5 * Meter,
+			args:      args{unit: Millimeter},
+			want:      1500,
+			wantExact: true,
+		},
+		{
+			name:      "Exact Negative",
+			d:         -3 * Feet,
+			args:      args{unit: Inch},
+			want:      -36,
+			wantExact: true,
+		},
+		{
+			name:      "Round-off",
+			d:         0.3 * Nanometer,
+			args:      args{unit: 0.1 * Nanometer},
+			want:      3,
+			wantExact: true,
+		},
+		{
+			name:      "Inexact",
+			d:         1.2345 * Meter,
+			args:      args{unit: Centimeter},
+			want:      123,
+			wantExact: false,
+		},
+		{
+			name:      "Zero Unit",
+			d:         Meter,
+			args:      args{unit: 0},
+			want:      0,
+			wantExact: false,
+		},
+		{
+			name:      "Out Of Range",
+			d:         Lightyear,
+			args:      args{unit: Nanometer},
+			want:      0,
+			wantExact: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExact := tt.d.CountOf(tt.args.unit)
+			if got != tt.want || gotExact != tt.wantExact {
+				t.Errorf("Distance.CountOf() = %v, %v, want %v, %v", got, gotExact, tt.want, tt.wantExact)
+			}
+		})
+	}
+}
+
+func TestDistance_Int64Nanometers(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       Distance
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:    "Meters",
+			d:       1.5 * Meter,
+			want:    1500000000,
+			wantErr: false,
+		},
+		{
+			name:    "Rounded",
+			d:       -2.5 * Nanometer,
+			want:    -3,
+			wantErr: false,
+		},
+		{
+			name:    "Largest",
+			d:       Distance(math.Nextafter(math.MaxInt64, 0)),
+			want:    math.MaxInt64 - 1023,
+			wantErr: false,
+		},
+		{
+			name:    "Smallest",
+			d:       math.MinInt64,
+			want:    math.MinInt64,
+			wantErr: false,
+		},
+		{
+			name:    "Too Large",
+			d:       math.MaxInt64,
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Too Small",
+			d:       -Lightyear,
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "NaN",
+			d:       Distance(math.NaN()),
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.Int64Nanometers()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.Int64Nanometers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.Int64Nanometers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromInt64Nanometers(t *testing.T) {
+	for _, n := range []int64{0, 1500000000, -3, math.MaxInt64 - 1023, math.MinInt64} {
+		got, err := FromInt64Nanometers(n).Int64Nanometers()
+		if err != nil {
+			t.Fatalf("Distance.Int64Nanometers() error = %v", err)
+		}
+		if got != n {
+			t.Errorf("FromInt64Nanometers(%d).Int64Nanometers() = %v", n, got)
+		}
+	}
+}
+
+func TestSumWithLoss(t *testing.T) {
+	tests := []struct {
+		name          string
+		ds            []Distance
+		wantSum       Distance
+		wantLostTerms int
+	}{
+		{
+			name:          "Empty",
+			ds:            nil,
+			wantSum:       0,
+			wantLostTerms: 0,
+		},
+		{
+			name:          "Similar Magnitudes",
+			ds:            []Distance{Meter, 2 * Meter, 50 * Centimeter},
+			wantSum:       3.5 * Meter,
+			wantLostTerms: 0,
+		},
+		{
+			name:          "Lightyear Plus Millimeters",
+			ds:            []Distance{Lightyear, Millimeter, Millimeter, 3 * Millimeter},
+			wantSum:       Lightyear,
+			wantLostTerms: 3,
+		},
+		{
+			name:          "Small Terms First Are Not Counted",
+			ds:            []Distance{Millimeter, Millimeter, Lightyear},
+			wantSum:       Lightyear,
+			wantLostTerms: 0,
+		},
+		{
+			name:          "Zero Terms Are Not Lost",
+			ds:            []Distance{Lightyear, 0, 0},
+			wantSum:       Lightyear,
+			wantLostTerms: 0,
+		},
+		{
+			name:          "Mixed",
+			ds:            []Distance{Kilometer, Nanometer, Lightyear, Meter, Nanometer},
+			wantSum:       Distance(float64(Kilometer+Nanometer) + float64(Lightyear) + float64(Meter)),
+			wantLostTerms: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSum, gotLostTerms := SumWithLoss(tt.ds)
+			if gotSum != tt.wantSum {
+				t.Errorf("SumWithLoss() gotSum = %v, want %v", gotSum, tt.wantSum)
+			}
+			if gotLostTerms != tt.wantLostTerms {
+				t.Errorf("SumWithLoss() gotLostTerms = %v, want %v", gotLostTerms, tt.wantLostTerms)
+			}
+		})
+	}
+}
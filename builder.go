@@ -0,0 +1,26 @@
+package length
+
+// A Builder builds a distance out of terms in mixed units, as in
+//
+//	d := length.NewBuilder().Add(5, length.Feet).Add(11, length.Inch).Build()
+//
+// which is the same distance as ParseDistance("5ft11in").
+type Builder struct {
+	d Distance
+}
+
+// NewBuilder returns a Builder for a distance that starts at zero.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Add adds n of unit to the distance being built and returns b.
+func (b *Builder) Add(n float64, unit Distance) *Builder {
+	b.d += Distance(n) * unit
+	return b
+}
+
+// Build returns the distance built so far.
+func (b *Builder) Build() Distance {
+	return b.d
+}
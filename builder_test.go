@@ -0,0 +1,43 @@
+package length
+
+import "testing"
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		name string
+		b    *Builder
+		s    string
+	}{
+		{
+			name: "Feet And Inches",
+			b:    NewBuilder().Add(5, Feet).Add(11, Inch),
+			s:    "5ft11in",
+		},
+		{
+			name: "Kilometers And Meters",
+			b:    NewBuilder().Add(1, Kilometer).Add(250, Meter),
+			s:    "1km250m",
+		},
+		{
+			name: "Fractions",
+			b:    NewBuilder().Add(1.5, Mile).Add(0.5, Yard),
+			s:    "1.5mi0.5yd",
+		},
+		{
+			name: "Empty",
+			b:    NewBuilder(),
+			s:    "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := ParseDistance(tt.s)
+			if err != nil {
+				t.Fatalf("ParseDistance() error = %v", err)
+			}
+			if got := tt.b.Build(); got != want {
+				t.Errorf("Builder.Build() = %v, want %v", got, want)
+			}
+		})
+	}
+}
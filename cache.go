@@ -0,0 +1,43 @@
+package length
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// parseCacheSize is the number of strings whose results
+// ParseDistanceCached remembers.
+var parseCacheSize int64 = 1024
+
+// parseCache holds the results of ParseDistanceCached.
+var parseCache struct {
+	results sync.Map // map[string]parseResult
+	n       atomic.Int64
+}
+
+type parseResult struct {
+	d   Distance
+	err error
+}
+
+// ParseDistanceCached is like ParseDistance, but remembers the results of
+// recently parsed strings, which saves time when the same few strings are
+// parsed over and over, such as those of a frequently reloaded config file.
+// Errors are remembered too, since parsing the same string always gives the
+// same result. The cache is bounded: once it holds 1024 strings it is
+// emptied, so memory use does not grow with the number of distinct inputs.
+// It is safe for concurrent use.
+func ParseDistanceCached(s string) (Distance, error) {
+	if r, ok := parseCache.results.Load(s); ok {
+		r := r.(parseResult)
+		return r.d, r.err
+	}
+	d, err := ParseDistance(s)
+	if _, loaded := parseCache.results.LoadOrStore(s, parseResult{d, err}); !loaded {
+		if parseCache.n.Add(1) > parseCacheSize {
+			parseCache.results.Clear()
+			parseCache.n.Store(0)
+		}
+	}
+	return d, err
+}
@@ -0,0 +1,80 @@
+package length
+
+import "testing"
+
+func TestParseDistanceCached(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name:    "Valid",
+			s:       "5ft11in",
+			want:    5*Feet + 11*Inch,
+			wantErr: false,
+		},
+		{
+			name:    "Invalid",
+			s:       "5furlongs",
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The second call is answered from the cache.
+			for i := 0; i < 2; i++ {
+				got, err := ParseDistanceCached(tt.s)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseDistanceCached() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseDistanceCached() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseDistanceCached_Eviction(t *testing.T) {
+	defer func(size int64) { parseCacheSize = size }(parseCacheSize)
+	parseCacheSize = 2
+	parseCache.results.Clear()
+	parseCache.n.Store(0)
+
+	cached := func(s string) bool {
+		_, ok := parseCache.results.Load(s)
+		return ok
+	}
+	ParseDistanceCached("1m")
+	ParseDistanceCached("2m")
+	ParseDistanceCached("1m")
+	if !cached("1m") || !cached("2m") {
+		t.Fatalf("ParseDistanceCached() did not cache results")
+	}
+	ParseDistanceCached("3m")
+	if cached("1m") || cached("2m") || cached("3m") {
+		t.Errorf("ParseDistanceCached() did not empty the full cache")
+	}
+	if got, err := ParseDistanceCached("1m"); got != Meter || err != nil {
+		t.Errorf("ParseDistanceCached() = %v, %v after eviction, want %v", got, err, Meter)
+	}
+	if !cached("1m") {
+		t.Errorf("ParseDistanceCached() did not cache results after eviction")
+	}
+}
+
+func BenchmarkParseDistance(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ParseDistance("5ft11.25in")
+	}
+}
+
+func BenchmarkParseDistanceCached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ParseDistanceCached("5ft11.25in")
+	}
+}
@@ -0,0 +1,66 @@
+package length
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// errNonFinite is returned when encoding an infinite or NaN distance
+// as text, which ParseDistance cannot read back.
+var errNonFinite = errors.New("length: cannot encode infinite or NaN distance")
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The distance is encoded in the form returned by Compact for the metric
+// system, such as "2.5m" rather than "2.500000m", whatever the system
+// selected by ToggleUnits, and UnmarshalText reads it back as exactly
+// the same distance. An error is returned if d is infinite or NaN.
+func (d Distance) MarshalText() ([]byte, error) {
+	if math.IsInf(float64(d), 0) || math.IsNaN(float64(d)) {
+		return nil, errNonFinite
+	}
+	return []byte(d.compact(Metric)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is parsed with ParseDistance.
+func (d *Distance) UnmarshalText(text []byte) error {
+	v, err := ParseDistance(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The distance is encoded as its float64 nanometer count in 8 bytes,
+// big-endian, so the encoding does not depend on the unit system in use.
+// Package encoding/gob also uses this encoding, so distances round-trip
+// through gob without any registration.
+func (d Distance) MarshalBinary() ([]byte, error) {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, 8), math.Float64bits(float64(d))), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It returns an error unless data is exactly 8 bytes long.
+func (d *Distance) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return errors.New("length: binary distance has " + strconv.Itoa(len(data)) + " bytes, want 8")
+	}
+	*d = Distance(math.Float64frombits(binary.BigEndian.Uint64(data)))
+	return nil
+}
+
+// Set implements the flag.Value interface, so that a distance can be
+// used as a command-line flag with flag.Var:
+//
+//	var radius length.Distance
+//	flag.Var(&radius, "radius", "search radius")
+//
+// The flag value is parsed with ParseDistance, and the default is shown
+// with String, the zero distance as "0m" or "0yd".
+func (d *Distance) Set(s string) error {
+	return d.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,301 @@
+package length
+
+import (
+	"bytes"
+	"encoding/gob"
+	"flag"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDistance_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		system  System
+		d       Distance
+		want    string
+		wantErr bool
+	}{
+		{name: "Meters", system: Metric, d: 2.5 * Meter, want: "2.5m"},
+		{name: "Whole", system: Metric, d: 2 * Meter, want: "2m"},
+		{name: "Zero", system: Metric, d: 0, want: "0m"},
+		{name: "Negative", system: Metric, d: -3 * Millimeter, want: "-3mm"},
+		{name: "Yards", system: Imperial, d: 100.5 * Yard, want: "91.8972m"},
+		{name: "Inches", system: Imperial, d: Inch, want: "2.54cm"},
+		{name: "Infinite", system: Metric, d: Distance(math.Inf(1)), wantErr: true},
+		{name: "Negative Infinite", system: Imperial, d: Distance(math.Inf(-1)), wantErr: true},
+		{name: "NaN", system: Metric, d: Distance(math.NaN()), wantErr: true},
+	}
+	defer UseMetric()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.system == Metric {
+				UseMetric()
+			} else {
+				UseImperial()
+			}
+			got, err := tt.d.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Distance.MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Distance.MarshalText() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    Distance
+		wantErr bool
+	}{
+		{name: "Meters", text: "2.5m", want: 2.5 * Meter},
+		{name: "Yards", text: "100.5yd", want: 100.5 * Yard},
+		{name: "Invalid", text: "2.5", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Distance
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.UnmarshalText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_TextRoundTrip(t *testing.T) {
+	ds := []Distance{
+		0,
+		Nanometer,
+		0.1 * Nanometer,
+		1.0 / 3 * Meter,
+		2.54 * Centimeter,
+		100.5 * Yard,
+		-1.9 * Mile,
+		5*Feet + 11*Inch,
+		Distance(math.Nextafter(float64(Meter), 0)),
+		123456.789 * Kilometer,
+	}
+	defer UseMetric()
+	for _, s := range []System{Metric, Imperial} {
+		if s == Metric {
+			UseMetric()
+		} else {
+			UseImperial()
+		}
+		for _, d := range ds {
+			text, err := d.MarshalText()
+			if err != nil {
+				t.Fatalf("Distance.MarshalText() error = %v", err)
+			}
+			var got Distance
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("Distance.UnmarshalText(%s) error = %v", text, err)
+			}
+			if got != d {
+				t.Errorf("round trip of %s = %v, want %v", text, float64(got), float64(d))
+			}
+		}
+	}
+}
+
+func TestDistance_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name: "Default",
+			args: nil,
+			want: 0,
+		},
+		{
+			name: "Separate Argument",
+			args: []string{"-radius", "2.5km"},
+			want: 2500 * Meter,
+		},
+		{
+			name: "Equals Sign",
+			args: []string{"-radius=5ft11in"},
+			want: 5*Feet + 11*Inch,
+		},
+		{
+			name:    "Invalid",
+			args:    []string{"-radius", "2furlongs"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			var radius Distance
+			fs.Var(&radius, "radius", "search radius")
+			if err := fs.Parse(tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("FlagSet.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && radius != tt.want {
+				t.Errorf("radius = %v, want %v", radius, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_SetDefaults(t *testing.T) {
+	tests := []struct {
+		name   string
+		system System
+		value  Distance
+		want   string
+	}{
+		{name: "Zero Metric", system: Metric, value: 0, want: "0m"},
+		{name: "Zero Imperial", system: Imperial, value: 0, want: "0yd"},
+		{name: "Nonzero", system: Metric, value: 2 * Meter, want: "(default 2.000000m)"},
+	}
+	defer UseMetric()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.system == Imperial {
+				UseImperial()
+			} else {
+				UseMetric()
+			}
+			radius := tt.value
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.Var(&radius, "radius", "search radius")
+			if got := fs.Lookup("radius").DefValue; tt.value == 0 && got != tt.want {
+				t.Errorf("DefValue = %q, want %q", got, tt.want)
+			}
+			var b strings.Builder
+			fs.SetOutput(&b)
+			fs.PrintDefaults()
+			if tt.value == 0 && strings.Contains(b.String(), "default") {
+				t.Errorf("PrintDefaults() = %q, want no default for the zero distance", b.String())
+			}
+			if tt.value != 0 && !strings.Contains(b.String(), tt.want) {
+				t.Errorf("PrintDefaults() = %q, want it to contain %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		want []byte
+	}{
+		{name: "Zero", d: 0, want: []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "One Nanometer", d: Nanometer, want: []byte{0x3f, 0xf0, 0, 0, 0, 0, 0, 0}},
+		{name: "Negative Two Nanometers", d: -2 * Nanometer, want: []byte{0xc0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.MarshalBinary()
+			if err != nil {
+				t.Fatalf("Distance.MarshalBinary() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Distance.MarshalBinary() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_BinaryRoundTrip(t *testing.T) {
+	ds := []Distance{0, Nanometer, -1.5 * Meter, 5*Feet + 11*Inch, Parsec, Picometer / 3, Distance(math.Inf(-1))}
+	for _, d := range ds {
+		for _, use := range []func(){UseMetric, UseImperial} {
+			use()
+			data, err := d.MarshalBinary()
+			if err != nil {
+				t.Fatalf("Distance.MarshalBinary() error = %v", err)
+			}
+			UseMetric()
+			var got Distance
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("Distance.UnmarshalBinary() error = %v", err)
+			}
+			if got != d {
+				t.Errorf("Distance.UnmarshalBinary(%x) = %v, want %v", data, got, d)
+			}
+		}
+	}
+}
+
+func TestDistance_UnmarshalBinary(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "Exact", data: []byte{0x3f, 0xf0, 0, 0, 0, 0, 0, 0}, wantErr: false},
+		{name: "Empty", data: nil, wantErr: true},
+		{name: "Truncated", data: []byte{0x3f, 0xf0, 0, 0, 0, 0, 0}, wantErr: true},
+		{name: "Too Long", data: []byte{0x3f, 0xf0, 0, 0, 0, 0, 0, 0, 0}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Meter
+			err := d.UnmarshalBinary(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.UnmarshalBinary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && d != Meter {
+				t.Errorf("Distance.UnmarshalBinary() changed the distance to %v on error", d)
+			}
+		})
+	}
+}
+
+func TestDistance_Gob(t *testing.T) {
+	type route struct {
+		Name     string
+		Length   Distance
+		Climb    Distance
+		Legs     []Distance
+		Detour   *Distance
+		Segments map[string]Distance
+	}
+	detour := -250 * Meter
+	want := route{
+		Name:     "coast",
+		Length:   42.195 * Kilometer,
+		Climb:    1024 * Feet,
+		Legs:     []Distance{5 * Mile, Picometer / 3, Parsec},
+		Detour:   &detour,
+		Segments: map[string]Distance{"bridge": 1.5 * NauticalMile},
+	}
+	UseImperial()
+	defer UseMetric()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	UseMetric()
+	var got route
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got.Detour == nil || *got.Detour != detour {
+		t.Errorf("Decode() Detour = %v, want %v", got.Detour, detour)
+	}
+	got.Detour, want.Detour = nil, nil
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/penguingovernor/length"
+)
+
+// unit returns the distance named by a unit suffix such as "km" or "ft".
+func unit(suffix string) (length.Distance, error) {
+	return length.ParseDistance("1" + suffix)
+}
+
+// convert reads distances from r, one per line, and writes each of them to w
+// as a number of the unit to. Lines holding a bare number are taken
+// to be in the unit from. Lines that cannot be parsed are reported to errw
+// and skipped. It returns the number of skipped lines.
+func convert(r io.Reader, w, errw io.Writer, from, to length.Distance) (int, error) {
+	bad := 0
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var d length.Distance
+		if v, err := strconv.ParseFloat(line, 64); err == nil {
+			d = length.Distance(v) * from
+		} else if d, err = length.ParseDistance(line); err != nil {
+			fmt.Fprintf(errw, "line %d: %v\n", n, err)
+			bad++
+			continue
+		}
+		fmt.Fprintln(w, float64(d/to))
+	}
+	return bad, scanner.Err()
+}
+
+func main() {
+
+	// Convert lengths read from standard input, for example:
+	// 	$ printf '5ft11in\n1.8\n' | go run ./examples/convert -from m -to in
+	from := flag.String("from", "m", "unit of lines that hold a bare number")
+	to := flag.String("to", "m", "unit to convert to")
+	flag.Parse()
+
+	fromUnit, err := unit(*from)
+	if err != nil {
+		log.Fatal(err)
+	}
+	toUnit, err := unit(*to)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	bad, err := convert(os.Stdin, os.Stdout, os.Stderr, fromUnit, toUnit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if bad > 0 {
+		os.Exit(1)
+	}
+
+	// Output:
+	// 71
+	// 70.86614173228347
+}
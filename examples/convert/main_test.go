@@ -0,0 +1,75 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/penguingovernor/length"
+)
+
+func Test_convert(t *testing.T) {
+	type args struct {
+		in   string
+		from length.Distance
+		to   length.Distance
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr string
+		wantBad int
+	}{
+		{
+			name: "Units In Input",
+			args: args{
+				in:   "5ft11in\n2.54cm\n",
+				from: length.Meter,
+				to:   length.Inch,
+			},
+			want:    "71\n1\n",
+			wantErr: "",
+			wantBad: 0,
+		},
+		{
+			name: "Bare Numbers",
+			args: args{
+				in:   "1.5\n\n-2\n",
+				from: length.Kilometer,
+				to:   length.Meter,
+			},
+			want:    "1500\n-2000\n",
+			wantErr: "",
+			wantBad: 0,
+		},
+		{
+			name: "Bad Lines",
+			args: args{
+				in:   "1km\n12furlongs\n2km\nabc\n",
+				from: length.Meter,
+				to:   length.Kilometer,
+			},
+			want:    "1\n2\n",
+			wantErr: "line 2: length: unknown unit furlongs in distance 12furlongs\nline 4: length: invalid distance abc\n",
+			wantBad: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w, errw strings.Builder
+			bad, err := convert(strings.NewReader(tt.args.in), &w, &errw, tt.args.from, tt.args.to)
+			if err != nil {
+				t.Fatalf("convert() error = %v", err)
+			}
+			if bad != tt.wantBad {
+				t.Errorf("convert() = %v, want %v", bad, tt.wantBad)
+			}
+			if got := w.String(); got != tt.want {
+				t.Errorf("convert() output = %q, want %q", got, tt.want)
+			}
+			if got := errw.String(); got != tt.wantErr {
+				t.Errorf("convert() errors = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package length
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A FeetInchesFormat formats distances in feet and inches, the way heights
+// and room sizes are usually written in the United States, such as
+// 5' 11" or 5 ft 11 in.
+// The zero FeetInchesFormat uses prime marks and rounds to the nearest inch.
+type FeetInchesFormat struct {
+	// Words selects the "5 ft 11 in" style rather than 5' 11".
+	Words bool
+
+	// Denominator is the fraction of an inch that the inches are
+	// rounded to, such as 16 for sixteenths, printed as 5' 11 1/2".
+	// Zero or one rounds to whole inches.
+	Denominator int
+
+	// symbols, if set, overrides Words and prints the units as "ft"
+	// and "in" after sep, as Formatter does.
+	symbols bool
+	sep     string
+
+	// decimal, if set, overrides Denominator and prints the inches
+	// with prec digits after the decimal point, or as many as needed
+	// if prec is negative.
+	decimal bool
+	prec    int
+}
+
+// Format returns a string representing the distance in feet and inches.
+// A distance of less than a foot is printed in inches only, such as 11",
+// and a whole number of feet in feet only, such as 6'.
+// Negative distances have a leading minus sign, such as -5' 11".
+// As with String, infinities are printed in feet, such as +Inf',
+// and NaN in inches.
+func (f FeetInchesFormat) Format(d Distance) string {
+	return string(f.append(make([]byte, 0, 16), d))
+}
+
+// append appends the result of f.Format(d) to dst and returns the
+// extended buffer.
+func (f FeetInchesFormat) append(dst []byte, d Distance) []byte {
+	footMark, inchMark := "'", `"`
+	switch {
+	case f.symbols:
+		footMark, inchMark = f.sep+"ft", f.sep+"in"
+	case f.Words:
+		footMark, inchMark = " ft", " in"
+	}
+	if math.IsNaN(float64(d)) {
+		// Like String, which prints NaN in the smallest unit.
+		return append(strconv.AppendFloat(dst, float64(d), 'f', -1, 64), inchMark...)
+	}
+	if math.IsInf(float64(d), 0) {
+		return append(strconv.AppendFloat(dst, float64(d), 'f', -1, 64), footMark...)
+	}
+
+	den := float64(f.Denominator)
+	switch {
+	case f.decimal && f.prec >= 0:
+		den = math.Pow10(f.prec)
+	case f.decimal || den < 1:
+		den = 1
+	}
+	// Count in units of 1/den inch so that rounding carries into feet.
+	n := math.Abs(float64(d / Inch))
+	if !f.decimal || f.prec >= 0 {
+		n = math.Round(n * den)
+	}
+	feet := math.Floor(n / (12 * den))
+	n -= feet * 12 * den
+
+	if d < 0 && (feet != 0 || n != 0) {
+		dst = append(dst, '-')
+	}
+	if feet != 0 {
+		dst = strconv.AppendFloat(dst, feet, 'f', 0, 64)
+		dst = append(dst, footMark...)
+		if n == 0 {
+			return dst
+		}
+		dst = append(dst, ' ')
+	}
+	if f.decimal {
+		dst = strconv.AppendFloat(dst, n/den, 'f', f.prec, 64)
+		return append(dst, inchMark...)
+	}
+	whole, num := int64(n/den), int64(math.Mod(n, den))
+	if whole != 0 || num == 0 {
+		dst = strconv.AppendInt(dst, whole, 10)
+	}
+	if num != 0 {
+		if whole != 0 {
+			dst = append(dst, ' ')
+		}
+		g := gcd(num, int64(den))
+		dst = strconv.AppendInt(dst, num/g, 10)
+		dst = append(dst, '/')
+		dst = strconv.AppendInt(dst, int64(den)/g, 10)
+	}
+	return append(dst, inchMark...)
+}
+
+// gcd returns the greatest common divisor of the positive integers a and b.
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// FormatFeetInches returns a string representing the distance in feet and
+// whole inches, such as 5' 11". It is shorthand for
+// FeetInchesFormat{}.Format(d); use a FeetInchesFormat to print words
+// or fractions of an inch.
+func (d Distance) FormatFeetInches() string {
+	return FeetInchesFormat{}.Format(d)
+}
+
+// FeetInches splits the distance into whole feet and the remaining inches,
+// so that 5.5ft is 5 feet and 6 inches. Both parts have the sign of d,
+// so -5.5ft is -5 feet and -6 inches, and the inches are always less
+// than 12 in magnitude.
+// The feet are unspecified if they do not fit in an int.
+func (d Distance) FeetInches() (feet int, inches float64) {
+	n, rem := splitUnit(d, Feet)
+	return n, float64(rem / Inch)
+}
+
+// DecomposeImperial splits the distance into whole yards, whole feet and
+// the remaining inches, so that 2yd 1ft 6.5in is 2 yards, 1 foot and
+// 6.5 inches. As for FeetInches, every part has the sign of d.
+func (d Distance) DecomposeImperial() (yd, ft int, in float64) {
+	yd, rem := splitUnit(d, Yard)
+	ft, rem = splitUnit(rem, Feet)
+	return yd, ft, float64(rem / Inch)
+}
+
+// splitUnit returns the whole number of unit in d, truncated toward
+// zero, and the exact remainder, which has the sign of d.
+func splitUnit(d, unit Distance) (int, Distance) {
+	rem := Distance(math.Mod(float64(d), float64(unit)))
+	return int(math.Round(float64((d - rem) / unit))), rem
+}
+
+// ParseFeetInches parses a distance in feet and inches as printed by
+// FeetInchesFormat, such as 5' 11", 5'11", 5 ft 11 in, 11 1/2" or -6'.
+// Either part may be left out, and the inches may be a whole number,
+// a decimal, a fraction or a whole number and a fraction.
+// The prime marks ′ and ″ are accepted in place of ' and ".
+func ParseFeetInches(s string) (Distance, error) {
+	orig := s
+	invalid := errors.New("length: invalid feet and inches " + orig)
+	s = strings.TrimSpace(s)
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	var feet, inches float64
+	found := false
+	for _, mark := range []string{"'", "′", "ft"} {
+		if i := strings.Index(s, mark); i >= 0 {
+			v, ok := parseDecimal(strings.TrimSpace(s[:i]))
+			if !ok {
+				return 0, invalid
+			}
+			feet, s, found = v, strings.TrimSpace(s[i+len(mark):]), true
+			break
+		}
+	}
+	if s != "" {
+		var num string
+		for _, mark := range []string{`"`, "″", "in"} {
+			if strings.HasSuffix(s, mark) {
+				num = strings.TrimSpace(strings.TrimSuffix(s, mark))
+				break
+			}
+		}
+		v, ok := parseMixedNumber(num)
+		if !ok {
+			return 0, invalid
+		}
+		inches, found = v, true
+	}
+	if !found {
+		return 0, invalid
+	}
+	d := Distance(feet)*Feet + Distance(inches)*Inch
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// parseMixedNumber parses a non-negative number written as a decimal,
+// such as "11.5", a fraction, such as "1/2", or a whole number and
+// a fraction, such as "11 1/2".
+func parseMixedNumber(s string) (float64, bool) {
+	if s == "" || strings.ContainsAny(s, "+-") {
+		return 0, false
+	}
+	var whole float64
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		w, err := strconv.ParseUint(s[:i], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		whole, s = float64(w), strings.TrimSpace(s[i+1:])
+		if !strings.Contains(s, "/") {
+			return 0, false
+		}
+	}
+	num, den, ok := strings.Cut(s, "/")
+	if !ok {
+		return parseDecimal(s)
+	}
+	n, err1 := strconv.ParseUint(num, 10, 64)
+	m, err2 := strconv.ParseUint(den, 10, 64)
+	if err1 != nil || err2 != nil || m == 0 {
+		return 0, false
+	}
+	return whole + float64(n)/float64(m), true
+}
+
+// parseDecimal parses a non-negative number written with decimal digits
+// and an optional decimal point, such as "11" or "11.5". Unlike
+// strconv.ParseFloat, it rejects signs, exponents, hexadecimal numbers,
+// underscores, and the words for infinity and NaN.
+func parseDecimal(s string) (float64, bool) {
+	digits, dots := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case isDigit(s[i]):
+			digits++
+		case s[i] == '.':
+			dots++
+		default:
+			return 0, false
+		}
+	}
+	if digits == 0 || dots > 1 {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	return v, err == nil
+}
@@ -0,0 +1,168 @@
+package length
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFeetInchesFormat_Format(t *testing.T) {
+	tests := []struct {
+		name string
+		f    FeetInchesFormat
+		d    Distance
+		want string
+	}{
+		{name: "Feet And Inches", f: FeetInchesFormat{}, d: 5*Feet + 11*Inch, want: `5' 11"`},
+		{name: "Words", f: FeetInchesFormat{Words: true}, d: 5*Feet + 11*Inch, want: "5 ft 11 in"},
+		{name: "Zero Feet", f: FeetInchesFormat{}, d: 11 * Inch, want: `11"`},
+		{name: "Zero Feet Words", f: FeetInchesFormat{Words: true}, d: 11 * Inch, want: "11 in"},
+		{name: "Whole Feet", f: FeetInchesFormat{}, d: 6 * Feet, want: "6'"},
+		{name: "Zero", f: FeetInchesFormat{}, d: 0, want: `0"`},
+		{name: "Negative", f: FeetInchesFormat{}, d: -(5*Feet + 11*Inch), want: `-5' 11"`},
+		{name: "Rounds To Inch", f: FeetInchesFormat{}, d: 5*Feet + 11.4*Inch, want: `5' 11"`},
+		{name: "Rounds Into Feet", f: FeetInchesFormat{}, d: 5*Feet + 11.6*Inch, want: "6'"},
+		{name: "Half Inch", f: FeetInchesFormat{Denominator: 16}, d: 5*Feet + 11.5*Inch, want: `5' 11 1/2"`},
+		{name: "Sixteenths", f: FeetInchesFormat{Denominator: 16}, d: 3.3125 * Inch, want: `3 5/16"`},
+		{name: "Fraction Only", f: FeetInchesFormat{Denominator: 4, Words: true}, d: 0.75 * Inch, want: "3/4 in"},
+		{name: "Meter", f: FeetInchesFormat{Denominator: 8}, d: Meter, want: `3' 3 3/8"`},
+		{name: "Negative Rounds To Zero", f: FeetInchesFormat{}, d: -0.2 * Inch, want: `0"`},
+		{name: "Infinity", f: FeetInchesFormat{}, d: Distance(math.Inf(1)), want: "+Inf'"},
+		{name: "Negative Infinity Words", f: FeetInchesFormat{Words: true, Denominator: 16}, d: Distance(math.Inf(-1)), want: "-Inf ft"},
+		{name: "NaN", f: FeetInchesFormat{}, d: Distance(math.NaN()), want: `NaN"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Format(tt.d); got != tt.want {
+				t.Errorf("FeetInchesFormat.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatFeetInches(t *testing.T) {
+	if got, want := (70 * Inch).FormatFeetInches(), `5' 10"`; got != want {
+		t.Errorf("Distance.FormatFeetInches() = %v, want %v", got, want)
+	}
+}
+
+func TestDistance_FeetInches(t *testing.T) {
+	tests := []struct {
+		name       string
+		d          Distance
+		wantFeet   int
+		wantInches float64
+	}{
+		{name: "Five And A Half Feet", d: 5.5 * Feet, wantFeet: 5, wantInches: 6},
+		{name: "Whole Feet", d: 6 * Feet, wantFeet: 6, wantInches: 0},
+		{name: "Feet And Inches", d: 5*Feet + 11*Inch, wantFeet: 5, wantInches: 11},
+		{name: "Fractional Inches", d: 5*Feet + 11.25*Inch, wantFeet: 5, wantInches: 11.25},
+		{name: "Under A Foot", d: 7 * Inch, wantFeet: 0, wantInches: 7},
+		{name: "Metric Distance", d: Meter, wantFeet: 3, wantInches: 100/2.54 - 36},
+		{name: "Negative", d: -5.5 * Feet, wantFeet: -5, wantInches: -6},
+		{name: "Negative Under A Foot", d: -3 * Inch, wantFeet: 0, wantInches: -3},
+		{name: "Zero", d: 0, wantFeet: 0, wantInches: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotFeet, gotInches := tt.d.FeetInches()
+			if gotFeet != tt.wantFeet || math.Abs(gotInches-tt.wantInches) > 1e-9 {
+				t.Errorf("Distance.FeetInches() = %v, %v, want %v, %v", gotFeet, gotInches, tt.wantFeet, tt.wantInches)
+			}
+			if back := Distance(gotFeet)*Feet + Distance(gotInches)*Inch; math.Abs(float64(back-tt.d)) > 1e-3 {
+				t.Errorf("%v ft %v in = %v, want %v", gotFeet, gotInches, back, tt.d)
+			}
+		})
+	}
+}
+
+func TestDistance_DecomposeImperial(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      Distance
+		wantYd int
+		wantFt int
+		wantIn float64
+	}{
+		{name: "Yards Feet And Inches", d: 2*Yard + Feet + 6.5*Inch, wantYd: 2, wantFt: 1, wantIn: 6.5},
+		{name: "Whole Yards", d: 3 * Yard, wantYd: 3, wantFt: 0, wantIn: 0},
+		{name: "Feet Only", d: 2 * Feet, wantYd: 0, wantFt: 2, wantIn: 0},
+		{name: "Mile", d: Mile, wantYd: 1760, wantFt: 0, wantIn: 0},
+		{name: "Metric Distance", d: Meter, wantYd: 1, wantFt: 0, wantIn: 100/2.54 - 36},
+		{name: "Negative", d: -(Yard + 2*Feet + Inch), wantYd: -1, wantFt: -2, wantIn: -1},
+		{name: "Zero", d: 0, wantYd: 0, wantFt: 0, wantIn: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotYd, gotFt, gotIn := tt.d.DecomposeImperial()
+			if gotYd != tt.wantYd || gotFt != tt.wantFt || math.Abs(gotIn-tt.wantIn) > 1e-9 {
+				t.Errorf("Distance.DecomposeImperial() = %v, %v, %v, want %v, %v, %v", gotYd, gotFt, gotIn, tt.wantYd, tt.wantFt, tt.wantIn)
+			}
+		})
+	}
+}
+
+func TestParseFeetInches(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Distance
+		wantErr bool
+	}{
+		{name: "Primes", s: `5' 11"`, want: 5*Feet + 11*Inch},
+		{name: "Primes No Space", s: `5'11"`, want: 5*Feet + 11*Inch},
+		{name: "Prime Symbols", s: "5′ 11″", want: 5*Feet + 11*Inch},
+		{name: "Words", s: "5 ft 11 in", want: 5*Feet + 11*Inch},
+		{name: "Feet Only", s: "6'", want: 6 * Feet},
+		{name: "Inches Only", s: "11 in", want: 11 * Inch},
+		{name: "Fraction", s: `5' 11 1/2"`, want: 5*Feet + 11.5*Inch},
+		{name: "Fraction Only", s: `3/4"`, want: 0.75 * Inch},
+		{name: "Decimal", s: `2.5'`, want: 2.5 * Feet},
+		{name: "Negative", s: `-5' 11"`, want: -(5*Feet + 11*Inch)},
+		{name: "Empty", s: "", wantErr: true},
+		{name: "No Marks", s: "5 11", wantErr: true},
+		{name: "Inches Without Mark", s: "5' 11", wantErr: true},
+		{name: "Zero Denominator", s: `1/0"`, wantErr: true},
+		{name: "Negative Inches", s: `5' -1"`, wantErr: true},
+		{name: "NaN Feet", s: "NaN'", wantErr: true},
+		{name: "Infinite Inches", s: `Inf"`, wantErr: true},
+		{name: "Infinity Feet", s: "infinity ft", wantErr: true},
+		{name: "Hex Feet", s: "0x1p4'", wantErr: true},
+		{name: "Exponent Inches", s: "1e2 in", wantErr: true},
+		{name: "Underscore Inches", s: `1_1"`, wantErr: true},
+		{name: "Lone Point", s: `.'`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFeetInches(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFeetInches() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if math.Abs(float64(got-tt.want)) > 1e-6 {
+				t.Errorf("ParseFeetInches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeetInchesFormat_RoundTrip(t *testing.T) {
+	formats := []FeetInchesFormat{{}, {Words: true}, {Denominator: 16}, {Words: true, Denominator: 8}}
+	ds := []Distance{0, 11 * Inch, 6 * Feet, 5*Feet + 11*Inch, -(5*Feet + 11.5*Inch), 3.3125 * Inch, 0.75 * Inch}
+	for _, f := range formats {
+		for _, d := range ds {
+			s := f.Format(d)
+			got, err := ParseFeetInches(s)
+			if err != nil {
+				t.Fatalf("ParseFeetInches(%q) error = %v", s, err)
+			}
+			den := f.Denominator
+			if den < 1 {
+				den = 1
+			}
+			// The round trip is exact up to the rounding of the format.
+			if math.Abs(float64(got-d)) > float64(Inch)/float64(2*den)+1e-6 {
+				t.Errorf("ParseFeetInches(%q) = %v, want %v", s, got, d)
+			}
+		}
+	}
+}
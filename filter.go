@@ -0,0 +1,120 @@
+package length
+
+import (
+	"math"
+	"sort"
+)
+
+// A MovingAverage smooths a stream of distance readings, such as those of
+// a noisy distance sensor, by averaging the most recent readings.
+type MovingAverage struct {
+	samples []Distance // ring buffer of the most recent readings
+	next    int        // index in samples of the next reading to replace
+	n       int        // number of readings in samples
+	sum     float64    // running sum of the readings
+	c       float64    // compensation for round-off in sum
+}
+
+// NewMovingAverage returns a MovingAverage over the last window readings.
+// It panics if window is not positive.
+func NewMovingAverage(window int) *MovingAverage {
+	if window <= 0 {
+		panic("length: non-positive window for NewMovingAverage")
+	}
+	return &MovingAverage{samples: make([]Distance, window)}
+}
+
+// Add records the reading d and returns the average of the readings
+// in the window, which holds fewer readings until it first fills up.
+// The running sum is updated in constant time and compensated for
+// floating point round-off, so it does not drift over long streams.
+func (m *MovingAverage) Add(d Distance) Distance {
+	if m.n == len(m.samples) {
+		m.add(-float64(m.samples[m.next]))
+	} else {
+		m.n++
+	}
+	m.samples[m.next] = d
+	m.next = (m.next + 1) % len(m.samples)
+	m.add(float64(d))
+	return Distance((m.sum + m.c) / float64(m.n))
+}
+
+// add adds x to the running sum using Neumaier's compensated summation.
+func (m *MovingAverage) add(x float64) {
+	t := m.sum + x
+	if math.Abs(m.sum) >= math.Abs(x) {
+		m.c += (m.sum - t) + x
+	} else {
+		m.c += (x - t) + m.sum
+	}
+	m.sum = t
+}
+
+// A MedianFilter smooths a stream of distance readings by taking the median
+// of the most recent readings. Unlike a MovingAverage, a single spike,
+// such as a spurious echo from an ultrasonic or LiDAR sensor, does not
+// affect its output.
+type MedianFilter struct {
+	samples []Distance // ring buffer of the most recent readings
+	next    int        // index in samples of the next reading to replace
+	sorted  []Distance // the readings in samples, in increasing order
+}
+
+// NewMedianFilter returns a MedianFilter over the last window readings.
+// It panics if window is not positive.
+func NewMedianFilter(window int) *MedianFilter {
+	if window <= 0 {
+		panic("length: non-positive window for NewMedianFilter")
+	}
+	return &MedianFilter{
+		samples: make([]Distance, window),
+		sorted:  make([]Distance, 0, window),
+	}
+}
+
+// Add records the reading d and returns the median of the readings in the
+// window, which holds fewer readings until it first fills up. When the
+// window holds an even number of readings, the mean of the middle two is
+// returned. The readings are kept sorted, so each call takes time
+// proportional to the window size.
+// A NaN reading, such as a failed measurement, is ignored: it is not
+// recorded, and Add returns the median of the readings already in the
+// window, or NaN if there are none.
+func (m *MedianFilter) Add(d Distance) Distance {
+	if math.IsNaN(float64(d)) {
+		if len(m.sorted) == 0 {
+			return d
+		}
+		return m.median()
+	}
+	if len(m.sorted) == len(m.samples) {
+		old := m.samples[m.next]
+		i := m.search(old)
+		m.sorted = append(m.sorted[:i], m.sorted[i+1:]...)
+	}
+	m.samples[m.next] = d
+	m.next = (m.next + 1) % len(m.samples)
+
+	i := m.search(d)
+	m.sorted = append(m.sorted, 0)
+	copy(m.sorted[i+1:], m.sorted[i:])
+	m.sorted[i] = d
+	return m.median()
+}
+
+// median returns the median of the readings in m.sorted, which must
+// not be empty.
+func (m *MedianFilter) median() Distance {
+	n := len(m.sorted)
+	if n%2 == 1 {
+		return m.sorted[n/2]
+	}
+	return m.sorted[n/2-1] + (m.sorted[n/2]-m.sorted[n/2-1])/2
+}
+
+// search returns the index of the first reading in m.sorted
+// that is not less than d.
+func (m *MedianFilter) search(d Distance) int {
+	return sort.Search(len(m.sorted), func(i int) bool { return m.sorted[i] >= d })
+}
@@ -0,0 +1,132 @@
+package length
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMovingAverage_Add(t *testing.T) {
+	tests := []struct {
+		name   string
+		window int
+		ds     []Distance
+		want   []Distance
+	}{
+		{
+			name:   "Window Of Three",
+			window: 3,
+			ds:     []Distance{3 * Meter, 6 * Meter, 9 * Meter, 12 * Meter, 3 * Meter},
+			want:   []Distance{3 * Meter, 4.5 * Meter, 6 * Meter, 9 * Meter, 8 * Meter},
+		},
+		{
+			name:   "Window Of One",
+			window: 1,
+			ds:     []Distance{3 * Meter, 6 * Meter, -9 * Meter},
+			want:   []Distance{3 * Meter, 6 * Meter, -9 * Meter},
+		},
+		{
+			name:   "Large And Small Readings",
+			window: 2,
+			ds:     []Distance{Lightyear, Nanometer, Nanometer, Nanometer},
+			want:   []Distance{Lightyear, Lightyear / 2, Nanometer, Nanometer},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMovingAverage(tt.window)
+			for i, d := range tt.ds {
+				if got := m.Add(d); got != tt.want[i] {
+					t.Errorf("MovingAverage.Add() #%d = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewMovingAverage_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewMovingAverage(0) did not panic")
+		}
+	}()
+	NewMovingAverage(0)
+}
+
+func TestMedianFilter_Add(t *testing.T) {
+	tests := []struct {
+		name   string
+		window int
+		ds     []Distance
+		want   []Distance
+	}{
+		{
+			name:   "Outlier Rejected",
+			window: 3,
+			ds:     []Distance{2 * Meter, 2 * Meter, 50 * Meter, 2 * Meter, 2 * Meter},
+			want:   []Distance{2 * Meter, 2 * Meter, 2 * Meter, 2 * Meter, 2 * Meter},
+		},
+		{
+			name:   "Even Window",
+			window: 4,
+			ds:     []Distance{1 * Meter, 4 * Meter, 2 * Meter, 3 * Meter, 9 * Meter},
+			want:   []Distance{1 * Meter, 2.5 * Meter, 2 * Meter, 2.5 * Meter, 3.5 * Meter},
+		},
+		{
+			name:   "Repeated Readings",
+			window: 3,
+			ds:     []Distance{5 * Meter, 5 * Meter, 1 * Meter, 1 * Meter, 1 * Meter},
+			want:   []Distance{5 * Meter, 5 * Meter, 5 * Meter, 1 * Meter, 1 * Meter},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMedianFilter(tt.window)
+			for i, d := range tt.ds {
+				if got := m.Add(d); got != tt.want[i] {
+					t.Errorf("MedianFilter.Add() #%d = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMedianFilter_Spike(t *testing.T) {
+	ds := []Distance{2 * Meter, 2 * Meter, 50 * Meter}
+	median, mean := NewMedianFilter(3), NewMovingAverage(3)
+	var gotMedian, gotMean Distance
+	for _, d := range ds {
+		gotMedian, gotMean = median.Add(d), mean.Add(d)
+	}
+	if gotMedian != 2*Meter {
+		t.Errorf("MedianFilter.Add() = %v, want %v", gotMedian, 2*Meter)
+	}
+	if gotMean != 18*Meter {
+		t.Errorf("MovingAverage.Add() = %v, want %v", gotMean, 18*Meter)
+	}
+}
+
+func TestMedianFilter_NaN(t *testing.T) {
+	nan := Distance(math.NaN())
+	m := NewMedianFilter(3)
+	if got := m.Add(nan); !math.IsNaN(float64(got)) {
+		t.Errorf("MedianFilter.Add(NaN) on empty window = %v, want NaN", got)
+	}
+	// Enough readings to evict every slot several times over; a NaN
+	// kept in the window used to panic when it was evicted.
+	ds := []Distance{Meter, nan, 3 * Meter, 2 * Meter, nan, 4 * Meter, 5 * Meter, nan, 6 * Meter}
+	want := []Distance{Meter, Meter, 2 * Meter, 2 * Meter, 2 * Meter, 3 * Meter, 4 * Meter, 4 * Meter, 5 * Meter}
+	for i, d := range ds {
+		if got := m.Add(d); got != want[i] {
+			t.Errorf("MedianFilter.Add(%v) #%d = %v, want %v", d, i, got, want[i])
+		}
+	}
+}
+
+func TestNewMedianFilter_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewMedianFilter(0) did not panic")
+		}
+	}()
+	NewMedianFilter(0)
+}
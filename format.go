@@ -0,0 +1,807 @@
+package length
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// siPrefixes lists the SI prefixes that are powers of one thousand,
+// ordered from smallest to largest.
+var siPrefixes = []struct {
+	symbol string
+	exp    int // power of ten
+}{
+	{"q", -30},
+	{"r", -27},
+	{"y", -24},
+	{"z", -21},
+	{"a", -18},
+	{"f", -15},
+	{"p", -12},
+	{"n", -9},
+	{"µ", -6}, // U+00B5 = micro symbol
+	{"m", -3},
+	{"", 0},
+	{"k", 3},
+	{"M", 6},
+	{"G", 9},
+	{"T", 12},
+	{"P", 15},
+	{"E", 18},
+	{"Z", 21},
+	{"Y", 24},
+	{"R", 27},
+	{"Q", 30},
+}
+
+// siBase is the index of the unprefixed meter in siPrefixes.
+const siBase = 10
+
+// FormatSI returns a string representing the distance in meters with the
+// SI prefix that keeps the leading number in the range [1, 1000),
+// such as "2.00 km", "500 nm" or "1.5 Gm".
+// The number is printed with prec digits after the decimal point;
+// a negative prec uses the smallest number of digits necessary to
+// represent the value exactly (see strconv.FormatFloat).
+// Only the prefixes that are powers of one thousand are used, so unlike
+// String the result is never in centimeters or in imperial units,
+// regardless of the unit system in use.
+func (d Distance) FormatSI(prec int) string {
+	return formatSI(d, prec, " ")
+}
+
+// FormatSI returns the shortest string representing the distance d in
+// meters with the SI prefix that keeps the leading number in the range
+// [1, 1000), with no space before the unit, such as "2.5Gm" or "-40µm".
+// Results in nm, µm, mm, m or km can be read back by ParseDistance.
+func FormatSI(d Distance) string {
+	return formatSI(d, -1, "")
+}
+
+// formatSI implements FormatSI with sep between the number and the unit.
+func formatSI(d Distance, prec int, sep string) string {
+	i := siIndex(d)
+	for {
+		v := strconv.FormatFloat(float64(d)/math.Pow10(siPrefixes[i].exp+9), 'f', prec, 64)
+		// Rounding may carry the number up to the next prefix (999.96 => 1000.0).
+		if f, _ := strconv.ParseFloat(v, 64); math.Abs(f) >= 1000 && !math.IsInf(f, 0) && i < len(siPrefixes)-1 {
+			i++
+			continue
+		}
+		return v + sep + siPrefixes[i].symbol + "m"
+	}
+}
+
+// siIndex returns the index of the prefix in siPrefixes that
+// best represents the distance d.
+func siIndex(d Distance) int {
+	m := math.Abs(float64(d / Meter))
+	if m == 0 || math.IsInf(m, 0) || math.IsNaN(m) {
+		return siBase
+	}
+	exp := int(math.Floor(math.Log10(m)))
+	i := siBase + int(math.Floor(float64(exp)/3))
+	if i < 0 {
+		return 0
+	}
+	if i >= len(siPrefixes) {
+		return len(siPrefixes) - 1
+	}
+	return i
+}
+
+// FormatRelative returns a string representing the magnitude of the
+// distance in the units of the system s, followed by aboveWord if the
+// distance is positive or belowWord if it is negative, such as
+// "2.500000m below" for a point below a datum.
+// The zero distance uses neither word.
+func (d Distance) FormatRelative(aboveWord, belowWord string, s System) string {
+	switch {
+	case d > 0:
+		return d.format(s) + " " + aboveWord
+	case d < 0:
+		return (-d).format(s) + " " + belowWord
+	}
+	return d.format(s)
+}
+
+// defaultPrecision is the number of digits after the decimal point
+// used to print distances.
+const defaultPrecision = 6
+
+// A Formatter prints distances like String does, but in a unit system
+// and style of its own rather than the one selected by ToggleUnits.
+// The Formatter returned by NewFormatter(Metric) prints distances
+// exactly like String.
+// A Formatter never reads or changes the package-level unit system,
+// so goroutines may each use their own Formatter, or share one that
+// is not modified, while others call ToggleUnits.
+type Formatter struct {
+	// System is the unit system used to print distances.
+	System System
+
+	// Separator is inserted between the number and the unit,
+	// such as " " for "2.000000 km", or "\u00a0" (a non-breaking space)
+	// to keep the two on one line in HTML. By default the unit
+	// directly follows the number.
+	Separator string
+
+	// SwitchThreshold is the fraction of a unit at which a distance
+	// is printed in that unit rather than the next smaller one.
+	// For example, with a threshold of 0.9, 950mm prints as "0.950000m"
+	// rather than "95.000000cm". The default of zero means 1.0,
+	// which switches to a unit when the distance reaches one of it.
+	SwitchThreshold float64
+
+	// Precision is the number of digits printed after the decimal point.
+	// NewFormatter sets it to 6, as for String; a negative precision
+	// uses the smallest number of digits necessary to represent the
+	// number exactly (see strconv.FormatFloat).
+	Precision int
+
+	// Compact, if set, overrides Precision and prints the fewest digits
+	// after the decimal point from which ParseDistance reads back exactly
+	// the same distance, so that 2m prints as "2m" and 1.25m as "1.25m".
+	// A distance that no number of its unit gives back exactly is
+	// printed in nanometers in the canonical form used by Quote.
+	Compact bool
+
+	// UseFeetInches, if set, prints distances as whole feet followed by the
+	// remaining inches, such as "5ft 11.000000in", whatever the System.
+	// The parts are those FeetInchesFormat prints, with the units written as
+	// symbols; Precision and Compact apply to the inches, so that with
+	// Compact 71 inches print as "5ft 11in", which ParseDistance reads back.
+	UseFeetInches bool
+}
+
+// NewFormatter returns a Formatter that prints distances in the unit system s
+// with six digits after the decimal point.
+func NewFormatter(s System) *Formatter {
+	return &Formatter{System: s, Precision: defaultPrecision}
+}
+
+// Format returns a string representing the distance in the form "10m" or "10yd".
+// See String for how the unit is chosen when SwitchThreshold is not set.
+func (f Formatter) Format(d Distance) string {
+	return f.format(d, f.Precision)
+}
+
+// format is like Format but prints prec digits after the decimal point.
+func (f Formatter) format(d Distance, prec int) string {
+	return string(f.append(make([]byte, 0, 24), d, prec))
+}
+
+// append appends the result of f.format(d, prec) to dst and
+// returns the extended buffer.
+func (f Formatter) append(dst []byte, d Distance, prec int) []byte {
+	if f.UseFeetInches {
+		return f.appendFeetInches(dst, d, prec)
+	}
+	threshold := f.SwitchThreshold
+	if threshold == 0 {
+		threshold = 1
+	}
+	u := switchUnit(d, f.System, threshold)
+	switch {
+	case d == 0:
+		dst = append(dst, '0')
+	case f.Compact:
+		num, ok := compactNumber(d, u.unit)
+		if !ok {
+			// Fall back to the canonical form, as Compact does.
+			u, num = namedUnit{Nanometer, "nm"}, strings.TrimSuffix(d.canonical(), "nm")
+		}
+		dst = append(dst, num...)
+	default:
+		n := len(dst)
+		dst = strconv.AppendFloat(dst, float64(d)/float64(u.unit), 'f', prec, 64)
+		// Rounding may carry the number up to the next unit (999.9999999m => 1000m).
+		if v, err := strconv.ParseFloat(string(dst[n:]), 64); err == nil {
+			if next := switchUnit(Distance(v)*u.unit, f.System, threshold); next.unit > u.unit {
+				u = next
+				dst = strconv.AppendFloat(dst[:n], float64(d)/float64(u.unit), 'f', prec, 64)
+			}
+		}
+	}
+	dst = append(dst, f.Separator...)
+	return append(dst, u.symbol...)
+}
+
+// appendFeetInches is like append for a Formatter with UseFeetInches set.
+func (f Formatter) appendFeetInches(dst []byte, d Distance, prec int) []byte {
+	fi := FeetInchesFormat{symbols: true, sep: f.Separator, decimal: true, prec: prec}
+	if !f.Compact {
+		return fi.append(dst, d)
+	}
+	// Like compactNumber, but the whole string must read back as d.
+	try := FeetInchesFormat{symbols: true, decimal: true}
+	for try.prec = 0; try.prec <= 17; try.prec++ {
+		if got, err := ParseDistance(try.Format(d)); err == nil && got == d {
+			fi.prec = try.prec
+			return fi.append(dst, d)
+		}
+	}
+	num := strings.TrimSuffix(d.canonical(), "nm")
+	dst = append(dst, num...)
+	dst = append(dst, f.Separator...)
+	return append(dst, "nm"...)
+}
+
+// compactNumber returns the number of unit in d with the fewest digits
+// after the decimal point that ParseDistance reads back as d. It reports
+// false if no number of unit gives back d exactly.
+func compactNumber(d, unit Distance) (string, bool) {
+	q := float64(d) / float64(unit)
+	// The quotient may be rounded the wrong way for the product to give
+	// back d, in which case one of its neighbors does.
+	for _, v := range []float64{q, math.Nextafter(q, math.Inf(1)), math.Nextafter(q, math.Inf(-1))} {
+		if v*float64(unit) != float64(d) {
+			continue
+		}
+		// The shortest form of v reads back as v itself, so no more digits
+		// than it has are ever needed, but fewer may still give back d.
+		exact := strconv.FormatFloat(v, 'f', -1, 64)
+		digits := 0
+		if i := strings.IndexByte(exact, '.'); i >= 0 {
+			digits = len(exact) - i - 1
+		}
+		for prec := 0; prec < digits; prec++ {
+			num := strconv.FormatFloat(v, 'f', prec, 64)
+			if got, err := strconv.ParseFloat(num, 64); err == nil && got*float64(unit) == float64(d) {
+				return num, true
+			}
+		}
+		return exact, true
+	}
+	return "", false
+}
+
+// AppendDistance appends to dst the string form of the distance d in the
+// system s with prec digits after the decimal point, as generated by String
+// when s is in use with that precision, and returns the extended buffer.
+// Like strconv.AppendFloat, it lets callers build output without
+// allocating, and it does not depend on the unit system selected by
+// ToggleUnits or the precision set by SetSystemPrecision.
+// A negative prec uses the smallest number of digits necessary
+// to represent the value exactly.
+func AppendDistance(dst []byte, d Distance, s System, prec int) []byte {
+	return Formatter{System: s}.append(dst, d, prec)
+}
+
+// lookupUnit returns the unit printed with the suffix symbol,
+// which must be one of the units accepted by ParseDistance.
+func lookupUnit(symbol string) (namedUnit, error) {
+	unit, ok := unitMap[symbol]
+	if !ok {
+		return namedUnit{}, errors.New("length: unknown unit " + symbol)
+	}
+	return namedUnit{Distance(unit), symbol}, nil
+}
+
+// FormatBankers returns a string representing the distance as a number of
+// the unit with the given suffix, such as "km" or "ft", rounded to decimals
+// digits after the decimal point. Ties are rounded half to even (banker's
+// rounding), so 2.5m prints as "2m" and 3.5m as "4m" with no decimals,
+// which avoids the upward bias of rounding ties away from zero when
+// many rounded values are added up.
+// An error is returned if the unit is unknown or decimals is negative.
+func (d Distance) FormatBankers(unit string, decimals int) (string, error) {
+	u, err := lookupUnit(unit)
+	if err != nil {
+		return "", err
+	}
+	if decimals < 0 {
+		return "", errors.New("length: negative decimals " + strconv.Itoa(decimals))
+	}
+	scale := math.Pow10(decimals)
+	v := math.RoundToEven(float64(d)/float64(u.unit)*scale) / scale
+	return strconv.FormatFloat(v, 'f', decimals, 64) + u.symbol, nil
+}
+
+// FormatFixed returns a string representing the distance as a number of
+// the unit with the given suffix, such as "m" or "ft", with exactly decimals
+// digits after the decimal point, such as "0.000002m" or "1500000.000000m".
+// Unlike String, the unit never changes with the magnitude of the distance,
+// so values printed with the same unit and decimals line up in columns.
+// An error is returned if the unit is unknown or decimals is negative.
+func (d Distance) FormatFixed(unit string, decimals int) (string, error) {
+	u, err := lookupUnit(unit)
+	if err != nil {
+		return "", err
+	}
+	if decimals < 0 {
+		return "", errors.New("length: negative decimals " + strconv.Itoa(decimals))
+	}
+	return d.formatIn(u, decimals), nil
+}
+
+// compactSuffixes lists the abbreviations used by FormatCompact for
+// successive powers of one thousand.
+var compactSuffixes = [...]string{"", "k", "M", "B", "T"}
+
+// FormatCompact returns a string representing the distance as a number of
+// the unit with the given suffix, such as "km" or "ft", abbreviated the way
+// counts are shown in dashboards: "1.5k km" for 1500 kilometers or
+// "2M m" for two million meters. The number keeps at most one digit after
+// the decimal point and drops it when it is zero. Counts of a trillion
+// or more are shown in trillions.
+// An error is returned if the unit is unknown.
+func (d Distance) FormatCompact(unit string) (string, error) {
+	u, err := lookupUnit(unit)
+	if err != nil {
+		return "", err
+	}
+	v := float64(d) / float64(u.unit)
+	i := 0
+	for i < len(compactSuffixes)-1 && math.Abs(math.Round(v*10)/10) >= 1000 {
+		v /= 1000
+		i++
+	}
+	num := strconv.FormatFloat(v, 'f', 1, 64)
+	num = strings.TrimSuffix(num, ".0")
+	if num == "-0" {
+		num = "0"
+	}
+	return num + compactSuffixes[i] + " " + u.symbol, nil
+}
+
+// FormatGrouped returns a string representing the distance as a number of
+// the unit with the given suffix, such as "km" or "ft", with sep inserted
+// between every three digits of the integer part, such as
+// "18,922,000,000,000,000 m". The digits after the decimal point are not
+// grouped. The number is printed with the smallest number of digits
+// necessary to represent it exactly (see strconv.FormatFloat).
+// An error is returned if the unit is unknown.
+func (d Distance) FormatGrouped(unit string, sep rune) (string, error) {
+	u, err := lookupUnit(unit)
+	if err != nil {
+		return "", err
+	}
+	v := float64(d) / float64(u.unit)
+	num := strconv.FormatFloat(v, 'f', -1, 64)
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return num + " " + u.symbol, nil
+	}
+	sign := ""
+	if num[0] == '-' {
+		sign, num = "-", num[1:]
+	}
+	intPart, frac := num, ""
+	if i := strings.IndexByte(num, '.'); i >= 0 {
+		intPart, frac = num[:i], num[i:]
+	}
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteRune(sep)
+		}
+		b.WriteRune(c)
+	}
+	b.WriteString(frac)
+	b.WriteString(" ")
+	b.WriteString(u.symbol)
+	return b.String(), nil
+}
+
+// EngineeringString returns a string representing the distance in meters
+// in engineering notation, such as "1.5e3 m" or "150e-6 m".
+// Unlike scientific notation the exponent is always a multiple of three,
+// so that it matches an SI prefix, and the mantissa lies in [1, 1000).
+// The exponent is found by rounding the decimal logarithm of the meter count
+// down to a multiple of three; if rounding the mantissa to prec digits
+// after the decimal point carries it up to 1000, the next exponent is used.
+// A negative prec uses the smallest number of digits necessary
+// to represent the mantissa exactly (see strconv.FormatFloat).
+func (d Distance) EngineeringString(prec int) string {
+	m := float64(d / Meter)
+	if m == 0 || math.IsInf(m, 0) || math.IsNaN(m) {
+		return strconv.FormatFloat(m, 'f', prec, 64) + "e0 m"
+	}
+	exp := int(math.Floor(math.Log10(math.Abs(m))/3)) * 3
+	if math.Abs(m) < math.Pow10(exp) {
+		// Log10 rounded up to the next power of 1000 (999.9999999999999 => 3).
+		exp -= 3
+	}
+	for {
+		mant := m
+		if exp < 0 {
+			mant *= math.Pow10(-exp)
+		} else {
+			mant /= math.Pow10(exp)
+		}
+		v := strconv.FormatFloat(mant, 'f', prec, 64)
+		if f, _ := strconv.ParseFloat(v, 64); math.Abs(f) >= 1000 {
+			exp += 3
+			continue
+		}
+		return v + "e" + strconv.Itoa(exp) + " m"
+	}
+}
+
+// commonUnit returns the unit of the system s that String would use
+// to print the longest of the distances ds.
+func commonUnit(ds []Distance, s System) namedUnit {
+	var max Distance
+	for _, d := range ds {
+		if d < 0 {
+			d = -d
+		}
+		if d > max {
+			max = d
+		}
+	}
+	return bestUnit(max, s)
+}
+
+// FormatTable returns the distances ds printed one per line in a common
+// unit of the system s, with the numbers right-aligned so that their
+// decimal points line up. The unit is the one that String would use
+// for the longest of the distances, and the number of digits after
+// the decimal point is the one set by SetSystemPrecision.
+func FormatTable(ds []Distance, s System) string {
+	u := commonUnit(ds, s)
+	nums := make([]string, len(ds))
+	width := 0
+	for i, d := range ds {
+		nums[i] = strconv.FormatFloat(float64(d)/float64(u.unit), 'f', s.precision(), 64)
+		if len(nums[i]) > width {
+			width = len(nums[i])
+		}
+	}
+	var b strings.Builder
+	for _, num := range nums {
+		b.WriteString(strings.Repeat(" ", width-len(num)))
+		b.WriteString(num)
+		b.WriteString(u.symbol)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// FormatMeasurement returns a string representing a measured value and its
+// uncertainty in the form "2.50 ± 0.03 m". Both numbers are printed in the
+// unit of the system s that String would use for the larger of the two,
+// with the same number of digits after the decimal point.
+// The number of digits follows the usual rule for reporting measurements:
+// the uncertainty is rounded to one significant figure, or to two if its
+// leading digit is 1. A zero uncertainty prints six digits.
+func FormatMeasurement(value, uncertainty Distance, s System) string {
+	u := commonUnit([]Distance{value, uncertainty}, s)
+	v := float64(value) / float64(u.unit)
+	e := math.Abs(float64(uncertainty) / float64(u.unit))
+	prec := defaultPrecision
+	if e != 0 && !math.IsInf(e, 0) && !math.IsNaN(e) {
+		exp := int(math.Floor(math.Log10(e)))
+		sig := 1
+		if int(e/math.Pow10(exp)) == 1 {
+			sig = 2
+		}
+		// Take the exponent of the rounded uncertainty, since rounding
+		// may carry it into the next digit (0.096 => 0.1).
+		r := strconv.FormatFloat(e, 'e', sig-1, 64)
+		exp, _ = strconv.Atoi(r[strings.IndexByte(r, 'e')+1:])
+		prec = sig - 1 - exp
+		if prec < 0 {
+			prec = 0
+		}
+	}
+	return strconv.FormatFloat(v, 'f', prec, 64) + " ± " +
+		strconv.FormatFloat(e, 'f', prec, 64) + " " + u.symbol
+}
+
+// Display returns a string representing the distance rounded to the nearest
+// multiple of roundTo, in the unit of the system s that String would use
+// for the rounded distance. The number of digits after the decimal point
+// is that of roundTo written in that unit, so that, for example,
+// (100 * Inch).Display(Metric, Centimeter) returns "2.54m" and
+// (40 * Inch).Display(Metric, Inch) returns "1.0160m". If roundTo has no
+// decimal form in the unit of six digits or fewer, such as an inch in
+// yards, there are just enough digits to tell its multiples apart.
+// If roundTo is not positive, d is not rounded and is printed with six digits.
+func (d Distance) Display(s System, roundTo Distance) string {
+	if roundTo <= 0 {
+		return Formatter{System: s}.format(d, defaultPrecision)
+	}
+	d = Distance(math.Round(float64(d/roundTo))) * roundTo
+	u := bestUnit(d, s)
+	step := float64(roundTo / u.unit)
+	prec := 0
+	if num := strconv.FormatFloat(step, 'f', -1, 64); strings.Contains(num, ".") {
+		prec = len(num) - strings.IndexByte(num, '.') - 1
+	}
+	if prec > defaultPrecision {
+		prec = max(int(math.Ceil(-math.Log10(step)-1e-9)), 0)
+	}
+	return Formatter{System: s}.format(d, prec)
+}
+
+// FormatRange returns a string representing the range of distances from
+// lo to hi, such as "1.000000–1.500000m". Both ends are printed in the unit
+// of the system s that String would use for the longer of the two, with the
+// number of digits after the decimal point set by SetSystemPrecision.
+// If lo is greater than hi, the two are swapped.
+func FormatRange(lo, hi Distance, s System) string {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	u := commonUnit([]Distance{lo, hi}, s)
+	prec := s.precision()
+	return strconv.FormatFloat(float64(lo)/float64(u.unit), 'f', prec, 64) + "–" +
+		strconv.FormatFloat(float64(hi)/float64(u.unit), 'f', prec, 64) + u.symbol
+}
+
+// FormatAuto returns a string representing the distance with two digits
+// after the decimal point, in the unit of the system s that reads most
+// naturally. It is like String, except that a distance that would round
+// to one of the next larger unit is printed in that unit: 0.998m prints
+// as "1.00m" rather than "99.80cm". In other words, a unit is used as soon
+// as the distance reaches 0.995 of it (a SwitchThreshold of 0.995).
+func (d Distance) FormatAuto(s System) string {
+	return Formatter{System: s, SwitchThreshold: 0.995}.format(d, 2)
+}
+
+// FormatTrimmed returns a string representing the distance in the unit
+// of the system s that String would use, with only as many digits after
+// the decimal point as the number of that unit needs, so 2m prints as "2m"
+// and 2.5m as "2.5m" rather than "2.000000m" and "2.500000m". The number is
+// the shortest decimal that identifies the float64 count of the unit
+// (see strconv.FormatFloat); unlike Compact, it is not checked to parse
+// back to exactly the same distance.
+func (d Distance) FormatTrimmed(s System) string {
+	return string(AppendDistance(nil, d, s, -1))
+}
+
+// FormatSigFigs returns a string representing the distance in the unit of
+// the system s that String would use, rounded to n significant figures,
+// such as "1.23m" for 1234mm with n = 3. Trailing zeros are kept to show
+// the precision, so 1.5m prints as "1.500m" with n = 4, and the number is
+// never printed in exponent form: 12345mi prints as "12000mi" with n = 2.
+// An n less than one is treated as one.
+func (d Distance) FormatSigFigs(n int, s System) string {
+	if n < 1 {
+		n = 1
+	}
+	u := bestUnit(d, s)
+	var e string
+	for {
+		// The 'e' format rounds to n significant figures for us.
+		e = strconv.FormatFloat(float64(d)/float64(u.unit), 'e', n-1, 64)
+		// Rounding may carry the number up to the next unit (999.6m => 1000m).
+		f, _ := strconv.ParseFloat(e, 64)
+		if v := bestUnit(Distance(f)*u.unit, s); v.unit > u.unit && !math.IsInf(f, 0) {
+			u = v
+			continue
+		}
+		break
+	}
+	sign := ""
+	if e[0] == '-' {
+		sign, e = "-", e[1:]
+	}
+	i := strings.IndexByte(e, 'e')
+	if i < 0 {
+		// Inf or NaN.
+		return sign + e + u.symbol
+	}
+	digits := strings.Replace(e[:i], ".", "", 1)
+	exp, _ := strconv.Atoi(e[i+1:])
+	var num string
+	switch {
+	case exp >= n-1:
+		num = digits + strings.Repeat("0", exp-(n-1))
+	case exp >= 0:
+		num = digits[:exp+1] + "." + digits[exp+1:]
+	default:
+		num = "0." + strings.Repeat("0", -exp-1) + digits
+	}
+	return sign + num + u.symbol
+}
+
+// PreferredString returns a string representing the distance like String,
+// but without the trailing zeros after the decimal point, so that very
+// small distances read cleanly: 0.0000015m prints as "1.5µm" rather than
+// "1.500000µm". The unit is chosen from the system selected by ToggleUnits,
+// and the number is rounded to six digits after the decimal point
+// before the zeros are dropped.
+func (d Distance) PreferredString() string {
+	s := currentSystem()
+	u := bestUnit(d, s)
+	var num string
+	for {
+		num = strconv.FormatFloat(float64(d)/float64(u.unit), 'f', defaultPrecision, 64)
+		// Rounding may carry the number up to the next unit (999.9999999m => 1000m).
+		f, _ := strconv.ParseFloat(num, 64)
+		if v := bestUnit(Distance(f)*u.unit, s); v.unit > u.unit && !math.IsInf(f, 0) {
+			u = v
+			continue
+		}
+		break
+	}
+	num = strings.TrimRight(num, "0")
+	num = strings.TrimSuffix(num, ".")
+	if num == "-0" {
+		num = "0"
+	}
+	return num + u.symbol
+}
+
+// Compact returns the shortest string representing the distance that
+// ParseDistance reads back as exactly d, such as "2m" or "1.5ft" rather
+// than String's "2.000000m". The unit is the one String would use in the
+// system selected by ToggleUnits, and the number is printed with the
+// fewest significant digits that round-trip. In the rare case that no
+// number of that unit round-trips, because converting to the unit and
+// back is inexact, the canonical nanometer form used by Quote is
+// returned instead.
+func (d Distance) Compact() string {
+	return d.compact(currentSystem())
+}
+
+// compact is like Compact but uses the system s.
+func (d Distance) compact(s System) string {
+	if d == 0 {
+		return "0" + bestUnit(d, s).symbol
+	}
+	// 17 significant digits identify any float64.
+	for n := 1; n <= 17; n++ {
+		str := d.FormatSigFigs(n, s)
+		if got, err := ParseDistance(str); err == nil && got == d {
+			return str
+		}
+	}
+	return d.canonical()
+}
+
+// Quote returns a double-quoted Go string literal holding the canonical
+// form of the distance: its exact nanometer count in the shortest decimal
+// that identifies it, in exponent form when large or small, such as
+// "1.5e6nm" or "0.25nm". Unquoting the result with strconv.Unquote and
+// parsing it with ParseDistance gives back exactly the same distance,
+// which makes the form suitable for generated code and configuration.
+// Infinite and NaN distances have no canonical form that ParseDistance
+// accepts.
+func (d Distance) Quote() string {
+	return strconv.Quote(d.canonical())
+}
+
+// canonical returns the unquoted canonical form used by Quote.
+func (d Distance) canonical() string {
+	num := strconv.FormatFloat(float64(d), 'g', -1, 64)
+	if i := strings.IndexByte(num, 'e'); i >= 0 {
+		// Shorten strconv's "e+06" to "e6" and "e-07" to "e-7".
+		exp, sign := num[i+2:], ""
+		if num[i+1] == '-' {
+			sign = "-"
+		}
+		num = num[:i+1] + sign + strings.TrimLeft(exp, "0")
+	}
+	return num + "nm"
+}
+
+// Format implements the fmt.Formatter interface, so that the printing
+// verbs control how a distance is printed in the unit that String would
+// choose from the system selected by ToggleUnits:
+//
+//	%v, %s	like String, or with the given number of digits after the
+//		decimal point, so %.1v prints "2.5m"
+//	%f, %F	with six digits after the decimal point by default
+//	%g, %G	with the given number of significant digits, or the
+//		smallest number necessary to represent the value exactly
+//	%d	rounded to a whole number of the unit
+//	%q	the quoted canonical form returned by Quote
+//
+// A width pads the result with spaces on the left, or on the right
+// with the '-' flag, and the '+' flag prints the sign of positive
+// distances. The %#v form prints the nanometer count as a Go float.
+// Other verbs print an error marker, as for any value of the wrong type.
+func (d Distance) Format(f fmt.State, verb rune) {
+	s := currentSystem()
+	prec, hasPrec := f.Precision()
+	var b []byte
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && f.Flag('#') {
+			b = strconv.AppendFloat(nil, float64(d), 'g', -1, 64)
+			break
+		}
+		if !hasPrec {
+			prec = s.precision()
+		}
+		b = AppendDistance(nil, d, s, prec)
+	case 'f', 'F':
+		if !hasPrec {
+			prec = defaultPrecision
+		}
+		b = AppendDistance(nil, d, s, prec)
+	case 'g', 'G':
+		if !hasPrec {
+			prec = -1
+		}
+		u := bestUnit(d, s)
+		b = strconv.AppendFloat(nil, float64(d)/float64(u.unit), byte(verb), prec, 64)
+		b = append(b, u.symbol...)
+	case 'd':
+		b = AppendDistance(nil, d, s, 0)
+	case 'q':
+		b = []byte(d.Quote())
+	default:
+		fmt.Fprintf(f, "%%!%c(length.Distance=%s)", verb, d.String())
+		return
+	}
+	if f.Flag('+') && b[0] != '-' && b[0] != '+' && verb != 'q' {
+		b = append([]byte{'+'}, b...)
+	}
+	pad := 0
+	if w, ok := f.Width(); ok {
+		pad = w - utf8.RuneCount(b)
+	}
+	if pad > 0 && !f.Flag('-') {
+		f.Write([]byte(strings.Repeat(" ", pad)))
+	}
+	f.Write(b)
+	if pad > 0 && f.Flag('-') {
+		f.Write([]byte(strings.Repeat(" ", pad)))
+	}
+}
+
+// A KilometersMeters formats distances in kilometers and meters,
+// such as "1 km 250 m", as some regions write road and trail lengths.
+// The zero KilometersMeters rounds to whole meters.
+type KilometersMeters struct {
+	// Decimals is the number of digits after the decimal point of
+	// the meters, such as 2 for "1 km 250.75 m". Negative values
+	// are treated as zero.
+	Decimals int
+}
+
+// Format returns a string representing the distance in kilometers and
+// meters. A distance of less than a kilometer is printed in meters only,
+// such as "250 m", and a whole number of kilometers in kilometers only,
+// such as "2 km". Negative distances have a leading minus sign.
+// As with String, infinities are printed in kilometers, such as
+// "+Inf km", and NaN in meters.
+func (f KilometersMeters) Format(d Distance) string {
+	if math.IsNaN(float64(d)) {
+		// Like String, which prints NaN in the smallest unit.
+		return strconv.FormatFloat(float64(d), 'f', -1, 64) + " m"
+	}
+	if math.IsInf(float64(d), 0) {
+		return strconv.FormatFloat(float64(d), 'f', -1, 64) + " km"
+	}
+	dec := max(f.Decimals, 0)
+	// Count in units of the last printed digit so that rounding
+	// carries into kilometers.
+	scale := math.Pow10(dec)
+	n := math.Round(math.Abs(float64(d/Meter)) * scale)
+	km := math.Floor(n / (1000 * scale))
+	n -= km * 1000 * scale
+
+	var b strings.Builder
+	if d < 0 && (km != 0 || n != 0) {
+		b.WriteByte('-')
+	}
+	if km != 0 {
+		b.WriteString(strconv.FormatFloat(km, 'f', 0, 64))
+		b.WriteString(" km")
+		if n == 0 {
+			return b.String()
+		}
+		b.WriteByte(' ')
+	}
+	b.WriteString(strconv.FormatFloat(n/scale, 'f', dec, 64))
+	b.WriteString(" m")
+	return b.String()
+}
+
+// FormatKmM returns a string representing the distance in kilometers and
+// whole meters, such as "1 km 250 m". It is shorthand for
+// KilometersMeters{}.Format(d); use a KilometersMeters to include
+// fractions of a meter.
+func (d Distance) FormatKmM() string {
+	return KilometersMeters{}.Format(d)
+}
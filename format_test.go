@@ -0,0 +1,1536 @@
+package length
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestDistance_FormatSI(t *testing.T) {
+	type args struct {
+		prec int
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want string
+	}{
+		{
+			name: "Zero",
+			d:    0,
+			args: args{prec: 2},
+			want: "0.00 m",
+		},
+		{
+			name: "2 Femtometers",
+			d:    2e-6 * Nanometer,
+			args: args{prec: 1},
+			want: "2.0 fm",
+		},
+		{
+			name: "500 Nanometers",
+			d:    500 * Nanometer,
+			args: args{prec: 0},
+			want: "500 nm",
+		},
+		{
+			name: "2 Centimeters",
+			d:    2 * Centimeter,
+			args: args{prec: 0},
+			want: "20 mm",
+		},
+		{
+			name: "250 Meters",
+			d:    250 * Meter,
+			args: args{prec: 2},
+			want: "250.00 m",
+		},
+		{
+			name: "2 Kilometers",
+			d:    2 * Kilometer,
+			args: args{prec: 2},
+			want: "2.00 km",
+		},
+		{
+			name: "1.5 Gigameters",
+			d:    1.5e6 * Kilometer,
+			args: args{prec: 1},
+			want: "1.5 Gm",
+		},
+		{
+			name: "Negative Megameters",
+			d:    -3.25e3 * Kilometer,
+			args: args{prec: -1},
+			want: "-3.25 Mm",
+		},
+		{
+			name: "Rounds Up To Next Prefix",
+			d:    999.96 * Meter,
+			args: args{prec: 1},
+			want: "1.0 km",
+		},
+		{
+			name: "Imperial Unit",
+			d:    1 * Mile,
+			args: args{prec: 3},
+			want: "1.609 km",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.FormatSI(tt.args.prec); got != tt.want {
+				t.Errorf("Distance.FormatSI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSI(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		want string
+	}{
+		{name: "Zero", d: 0, want: "0m"},
+		{name: "1 Quectometer", d: 1e-21 * Nanometer, want: "1qm"},
+		{name: "3 Attometers", d: 3e-9 * Nanometer, want: "3am"},
+		{name: "450 Picometers", d: 0.45 * Nanometer, want: "450pm"},
+		{name: "1 Nanometer", d: Nanometer, want: "1nm"},
+		{name: "Negative Micrometers", d: -40 * Micrometer, want: "-40µm"},
+		{name: "1 Centimeter", d: Centimeter, want: "10mm"},
+		{name: "12.5 Meters", d: 12.5 * Meter, want: "12.5m"},
+		{name: "999 Meters", d: 999 * Meter, want: "999m"},
+		{name: "1 Kilometer", d: Kilometer, want: "1km"},
+		{name: "1 Mile", d: Mile, want: "1.609344km"},
+		{name: "2.5 Gigameters", d: 2.5e9 * Meter, want: "2.5Gm"},
+		{name: "1 Terameter", d: 1e12 * Meter, want: "1Tm"},
+		{name: "1 Astronomical Unit", d: AstronomicalUnit, want: "149.5978707Gm"},
+		{name: "7 Exameters", d: 7e18 * Meter, want: "7Em"},
+		{name: "1 Quettameter", d: 1e30 * Meter, want: "1Qm"},
+		{name: "Infinity", d: Distance(math.Inf(1)), want: "+Infm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatSI(tt.d); got != tt.want {
+				t.Errorf("FormatSI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSI_ParseDistance(t *testing.T) {
+	for exp := -9; exp < 6; exp++ {
+		d := 1.25 * Distance(math.Pow10(exp)) * Meter
+		s := FormatSI(d)
+		got, err := ParseDistance(s)
+		if err != nil {
+			t.Errorf("ParseDistance(%q) error = %v", s, err)
+			continue
+		}
+		if math.Abs(float64(got-d)) > 1e-12*float64(d) {
+			t.Errorf("ParseDistance(%q) = %v, want %v", s, got, d)
+		}
+	}
+}
+
+func TestDistance_FormatRelative(t *testing.T) {
+	type args struct {
+		aboveWord string
+		belowWord string
+		s         System
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want string
+	}{
+		{
+			name: "Above",
+			d:    2.5 * Meter,
+			args: args{aboveWord: "above", belowWord: "below", s: Metric},
+			want: "2.500000m above",
+		},
+		{
+			name: "Below",
+			d:    -2.5 * Meter,
+			args: args{aboveWord: "above", belowWord: "below", s: Metric},
+			want: "2.500000m below",
+		},
+		{
+			name: "Below Imperial",
+			d:    -2 * Feet,
+			args: args{aboveWord: "up", belowWord: "down", s: Imperial},
+			want: "2.000000ft down",
+		},
+		{
+			name: "Zero",
+			d:    0,
+			args: args{aboveWord: "above", belowWord: "below", s: Metric},
+			want: "0m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.FormatRelative(tt.args.aboveWord, tt.args.belowWord, tt.args.s); got != tt.want {
+				t.Errorf("Distance.FormatRelative() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		s    System
+		d    Distance
+		want string
+	}{
+		{name: "Metric", s: Metric, d: 1.5 * Meter, want: "1.500000m"},
+		{name: "Imperial", s: Imperial, d: 3 * Feet, want: "1.000000yd"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFormatter(tt.s).Format(tt.d); got != tt.want {
+				t.Errorf("NewFormatter().Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_UseFeetInches(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Formatter
+		d    Distance
+		want string
+	}{
+		{
+			name: "Default Precision",
+			f:    Formatter{UseFeetInches: true, Precision: 6},
+			d:    5*Feet + 11*Inch,
+			want: "5ft 11.000000in",
+		},
+		{
+			name: "Compact",
+			f:    Formatter{UseFeetInches: true, Compact: true},
+			d:    5*Feet + 11*Inch,
+			want: "5ft 11in",
+		},
+		{
+			name: "Compact Fractional Inches",
+			f:    Formatter{UseFeetInches: true, Compact: true},
+			d:    5*Feet + 11.5*Inch,
+			want: "5ft 11.5in",
+		},
+		{
+			name: "Precision",
+			f:    Formatter{UseFeetInches: true, Precision: 2},
+			d:    Meter,
+			want: "3ft 3.37in",
+		},
+		{
+			name: "Whole Feet",
+			f:    Formatter{UseFeetInches: true, Compact: true},
+			d:    6 * Feet,
+			want: "6ft",
+		},
+		{
+			name: "Inches Only",
+			f:    Formatter{UseFeetInches: true, Compact: true},
+			d:    7 * Inch,
+			want: "7in",
+		},
+		{
+			name: "Rounds Up To Next Foot",
+			f:    Formatter{UseFeetInches: true, Precision: 1},
+			d:    6*Feet - Millimeter,
+			want: "6ft",
+		},
+		{
+			name: "Negative",
+			f:    Formatter{UseFeetInches: true, Compact: true},
+			d:    -(5*Feet + 11*Inch),
+			want: "-5ft 11in",
+		},
+		{
+			name: "Separator",
+			f:    Formatter{UseFeetInches: true, Compact: true, Separator: " "},
+			d:    5*Feet + 11*Inch,
+			want: "5 ft 11 in",
+		},
+		{
+			name: "Overrides System",
+			f:    Formatter{System: Metric, UseFeetInches: true, Compact: true},
+			d:    2 * Yard,
+			want: "6ft",
+		},
+		{
+			name: "Zero",
+			f:    Formatter{UseFeetInches: true, Precision: 6},
+			d:    0,
+			want: "0.000000in",
+		},
+		{
+			name: "Infinity",
+			f:    Formatter{UseFeetInches: true, Precision: 6},
+			d:    Distance(math.Inf(1)),
+			want: "+Infft",
+		},
+		{
+			name: "Zero Compact",
+			f:    Formatter{UseFeetInches: true, Compact: true, Separator: " "},
+			d:    0,
+			want: "0 in",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Format(tt.d); got != tt.want {
+				t.Errorf("Formatter.Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_UseFeetInchesRoundTrip(t *testing.T) {
+	ds := []Distance{0, 5*Feet + 11*Inch, 6 * Feet, 7 * Inch, -(5*Feet + 11.25*Inch), Meter, Mile, Meter / 3, Nanometer}
+	for _, sep := range []string{"", " "} {
+		f := Formatter{UseFeetInches: true, Compact: true, Separator: sep}
+		for _, d := range ds {
+			s := f.Format(d)
+			got, err := ParseDistance(s)
+			if err != nil || got != d {
+				t.Errorf("ParseDistance(%q) = %v, %v, want %v", s, got, err, d)
+			}
+		}
+	}
+	if got, err := ParseDistance("5ft11in"); err != nil || got != 5*Feet+11*Inch {
+		t.Errorf("ParseDistance(%q) = %v, %v, want %v", "5ft11in", got, err, 5*Feet+11*Inch)
+	}
+}
+
+func TestFormatter_CompactRoundTrip(t *testing.T) {
+	ds := []Distance{Meter / 3, Meter + Nanometer, 5*Feet + 11*Inch, -Parsec, Picometer / 7, 1e-300 * Nanometer, 0.1 * Nanometer}
+	for _, s := range []System{Metric, Imperial} {
+		f := Formatter{System: s, Compact: true}
+		for _, d := range ds {
+			str := f.Format(d)
+			got, err := ParseDistance(str)
+			if err != nil || got != d {
+				t.Errorf("ParseDistance(%q) = %v, %v, want %v", str, got, err, d)
+			}
+		}
+	}
+}
+
+// TestFormatter_Concurrent formats in both systems from many goroutines
+// at once. Run with -race to check that Formatters share no state.
+func TestFormatter_Concurrent(t *testing.T) {
+	metric, imperial := NewFormatter(Metric), NewFormatter(Imperial)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if got, want := metric.Format(2*Meter), "2.000000m"; got != want {
+					t.Errorf("Formatter.Format() = %v, want %v", got, want)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if got, want := imperial.Format(2*Yard), "2.000000yd"; got != want {
+					t.Errorf("Formatter.Format() = %v, want %v", got, want)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = Formatter{System: Imperial, Separator: " "}.Format(Inch)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFormatter_Format(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Formatter
+		d    Distance
+		want string
+	}{
+		{
+			name: "Metric Precision",
+			f:    Formatter{Precision: 2},
+			d:    1234 * Millimeter,
+			want: "1.23m",
+		},
+		{
+			name: "Imperial Precision",
+			f:    Formatter{System: Imperial, Precision: 1},
+			d:    5 * Feet,
+			want: "1.7yd",
+		},
+		{
+			name: "Negative Precision",
+			f:    Formatter{Precision: -1},
+			d:    1.25 * Meter,
+			want: "1.25m",
+		},
+		{
+			name: "Metric Compact",
+			f:    Formatter{Compact: true},
+			d:    2 * Meter,
+			want: "2m",
+		},
+		{
+			name: "Imperial Compact",
+			f:    Formatter{System: Imperial, Compact: true},
+			d:    18 * Inch,
+			want: "1.5ft",
+		},
+		{
+			name: "Compact Overrides Precision",
+			f:    Formatter{Precision: 4, Compact: true},
+			d:    1.25 * Meter,
+			want: "1.25m",
+		},
+		{
+			name: "Compact Keeps Needed Digits",
+			f:    Formatter{Precision: 1, Compact: true},
+			d:    1.125 * Meter,
+			want: "1.125m",
+		},
+		{
+			name: "Compact Falls Back To Canonical",
+			f:    Formatter{Separator: " ", Compact: true},
+			d:    Meter + Nanometer,
+			want: "1.000000001e9 nm",
+		},
+		{
+			name: "Compact Zero",
+			f:    Formatter{System: Imperial, Compact: true},
+			d:    0,
+			want: "0yd",
+		},
+		{
+			name: "Compact With Separator And Threshold",
+			f:    Formatter{Separator: " ", SwitchThreshold: 0.9, Compact: true},
+			d:    950 * Millimeter,
+			want: "0.95 m",
+		},
+		{
+			name: "Precision With Separator",
+			f:    Formatter{System: Imperial, Separator: " ", Precision: 3},
+			d:    -Inch,
+			want: "-1.000 in",
+		},
+		{
+			name: "Zero Precision",
+			f:    Formatter{},
+			d:    2.25 * Meter,
+			want: "2m",
+		},
+		{
+			name: "Zero Precision With Separator",
+			f:    Formatter{System: Imperial, Separator: " "},
+			d:    -3.25 * Inch,
+			want: "-3 in",
+		},
+		{
+			name: "Imperial",
+			f:    Formatter{System: Imperial, Precision: 6},
+			d:    2 * Feet,
+			want: "2.000000ft",
+		},
+		{
+			name: "Space Separator",
+			f:    Formatter{Separator: " ", Precision: 6},
+			d:    25 * Millimeter,
+			want: "2.500000 cm",
+		},
+		{
+			name: "Non-breaking Space Separator",
+			f:    Formatter{System: Imperial, Separator: "\u00a0", Precision: 6},
+			d:    3 * Yard,
+			want: "3.000000\u00a0yd",
+		},
+		{
+			name: "Switch Threshold",
+			f:    Formatter{SwitchThreshold: 0.9, Precision: 6},
+			d:    950 * Millimeter,
+			want: "0.950000m",
+		},
+		{
+			name: "Below Switch Threshold",
+			f:    Formatter{SwitchThreshold: 0.9, Precision: 6},
+			d:    850 * Millimeter,
+			want: "85.000000cm",
+		},
+		{
+			name: "Negative Switch Threshold",
+			f:    Formatter{System: Imperial, SwitchThreshold: 0.75, Precision: 6},
+			d:    -30 * Inch,
+			want: "-0.833333yd",
+		},
+		{
+			name: "Default Switch Threshold",
+			f:    Formatter{Precision: 6},
+			d:    950 * Millimeter,
+			want: "95.000000cm",
+		},
+		{
+			name: "Zero With Separator",
+			f:    Formatter{Separator: " "},
+			d:    0,
+			want: "0 m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Format(tt.d); got != tt.want {
+				t.Errorf("Formatter.Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatBankers(t *testing.T) {
+	type args struct {
+		unit     string
+		decimals int
+	}
+	tests := []struct {
+		name    string
+		d       Distance
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Half Down To Even",
+			d:       2.5 * Meter,
+			args:    args{unit: "m", decimals: 0},
+			want:    "2m",
+			wantErr: false,
+		},
+		{
+			name:    "Half Up To Even",
+			d:       3.5 * Meter,
+			args:    args{unit: "m", decimals: 0},
+			want:    "4m",
+			wantErr: false,
+		},
+		{
+			name:    "Negative Half To Even",
+			d:       -2.5 * Meter,
+			args:    args{unit: "m", decimals: 0},
+			want:    "-2m",
+			wantErr: false,
+		},
+		{
+			name:    "Half At Decimals",
+			d:       1.125 * Kilometer,
+			args:    args{unit: "km", decimals: 2},
+			want:    "1.12km",
+			wantErr: false,
+		},
+		{
+			name:    "Not A Tie",
+			d:       2.51 * Meter,
+			args:    args{unit: "m", decimals: 0},
+			want:    "3m",
+			wantErr: false,
+		},
+		{
+			name:    "Other Unit",
+			d:       30 * Inch,
+			args:    args{unit: "ft", decimals: 0},
+			want:    "2ft",
+			wantErr: false,
+		},
+		{
+			name:    "Unknown Unit",
+			d:       2.5 * Meter,
+			args:    args{unit: "furlong", decimals: 0},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "Negative Decimals",
+			d:       2.5 * Meter,
+			args:    args{unit: "m", decimals: -1},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.FormatBankers(tt.args.unit, tt.args.decimals)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.FormatBankers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.FormatBankers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatFixed(t *testing.T) {
+	type args struct {
+		unit     string
+		decimals int
+	}
+	tests := []struct {
+		name    string
+		d       Distance
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Tiny In Meters",
+			d:    2 * Micrometer,
+			args: args{unit: "m", decimals: 6},
+			want: "0.000002m",
+		},
+		{
+			name: "Huge In Meters",
+			d:    1500 * Kilometer,
+			args: args{unit: "m", decimals: 6},
+			want: "1500000.000000m",
+		},
+		{
+			name: "Rounded",
+			d:    1.23456 * Meter,
+			args: args{unit: "m", decimals: 2},
+			want: "1.23m",
+		},
+		{
+			name: "No Decimals",
+			d:    -2.6 * Feet,
+			args: args{unit: "ft", decimals: 0},
+			want: "-3ft",
+		},
+		{
+			name:    "Negative Decimals",
+			d:       Meter,
+			args:    args{unit: "m", decimals: -1},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Unit",
+			d:       Meter,
+			args:    args{unit: "furlong", decimals: 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.FormatFixed(tt.args.unit, tt.args.decimals)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.FormatFixed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.FormatFixed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatCompact(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       Distance
+		unit    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Below A Thousand",
+			d:    250 * Meter,
+			unit: "m",
+			want: "250 m",
+		},
+		{
+			name: "Thousands",
+			d:    1500 * Kilometer,
+			unit: "km",
+			want: "1.5k km",
+		},
+		{
+			name: "Whole Thousands",
+			d:    12000 * Feet,
+			unit: "ft",
+			want: "12k ft",
+		},
+		{
+			name: "Millions",
+			d:    2.26e6 * Meter,
+			unit: "m",
+			want: "2.3M m",
+		},
+		{
+			name: "Billions",
+			d:    3e9 * Meter,
+			unit: "m",
+			want: "3B m",
+		},
+		{
+			name: "Trillions And Beyond",
+			d:    4.5e15 * Meter,
+			unit: "m",
+			want: "4500T m",
+		},
+		{
+			name: "Rounds Up To Next Suffix",
+			d:    999.96 * Kilometer,
+			unit: "km",
+			want: "1k km",
+		},
+		{
+			name: "Negative",
+			d:    -1500 * Meter,
+			unit: "m",
+			want: "-1.5k m",
+		},
+		{
+			name: "Zero",
+			d:    0,
+			unit: "mi",
+			want: "0 mi",
+		},
+		{
+			name:    "Unknown Unit",
+			d:       Meter,
+			unit:    "furlong",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.FormatCompact(tt.unit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.FormatCompact() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.FormatCompact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatGrouped(t *testing.T) {
+	type args struct {
+		unit string
+		sep  rune
+	}
+	tests := []struct {
+		name    string
+		d       Distance
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Two Lightyears In Meters",
+			d:    2 * Lightyear,
+			args: args{unit: "m", sep: ','},
+			want: "18,922,000,000,000,000 m",
+		},
+		{
+			name: "Small",
+			d:    999 * Meter,
+			args: args{unit: "m", sep: ','},
+			want: "999 m",
+		},
+		{
+			name: "Four Digits",
+			d:    1000 * Meter,
+			args: args{unit: "m", sep: ','},
+			want: "1,000 m",
+		},
+		{
+			name: "Decimals Not Grouped",
+			d:    1234567.125 * Meter,
+			args: args{unit: "m", sep: ','},
+			want: "1,234,567.125 m",
+		},
+		{
+			name: "Negative",
+			d:    -123456 * Kilometer,
+			args: args{unit: "km", sep: ','},
+			want: "-123,456 km",
+		},
+		{
+			name: "Thin Space",
+			d:    12345 * Feet,
+			args: args{unit: "ft", sep: '\u2009'},
+			want: "12\u2009345 ft",
+		},
+		{
+			name:    "Unknown Unit",
+			d:       Meter,
+			args:    args{unit: "furlong", sep: ','},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.FormatGrouped(tt.args.unit, tt.args.sep)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.FormatGrouped() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.FormatGrouped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_EngineeringString(t *testing.T) {
+	type args struct {
+		prec int
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want string
+	}{
+		{
+			name: "Zero",
+			d:    0,
+			args: args{prec: -1},
+			want: "0e0 m",
+		},
+		{
+			name: "Exponent 3",
+			d:    1500 * Meter,
+			args: args{prec: -1},
+			want: "1.5e3 m",
+		},
+		{
+			name: "Exponent 4",
+			d:    12346 * Meter,
+			args: args{prec: 2},
+			want: "12.35e3 m",
+		},
+		{
+			name: "Exponent 2",
+			d:    250 * Meter,
+			args: args{prec: -1},
+			want: "250e0 m",
+		},
+		{
+			name: "Exponent -1",
+			d:    15 * Centimeter,
+			args: args{prec: -1},
+			want: "150e-3 m",
+		},
+		{
+			name: "Exponent -4",
+			d:    150 * Micrometer,
+			args: args{prec: -1},
+			want: "150e-6 m",
+		},
+		{
+			name: "Negative",
+			d:    -2 * Millimeter,
+			args: args{prec: 1},
+			want: "-2.0e-3 m",
+		},
+		{
+			name: "Rounds Up To Next Exponent",
+			d:    999.96 * Kilometer,
+			args: args{prec: 1},
+			want: "1.0e6 m",
+		},
+		{
+			name: "Just Below Exponent Boundary",
+			d:    999.9999999999999 * Meter,
+			args: args{prec: -1},
+			want: "999.9999999999999e0 m",
+		},
+		{
+			name: "Just Below Negative Exponent Boundary",
+			d:    -0.9999999999999999 * Millimeter,
+			args: args{prec: 3},
+			want: "-1.000e-3 m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.EngineeringString(tt.args.prec); got != tt.want {
+				t.Errorf("Distance.EngineeringString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTable(t *testing.T) {
+	type args struct {
+		ds []Distance
+		s  System
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Metric",
+			args: args{
+				ds: []Distance{1.5 * Meter, 25 * Centimeter, -12.25 * Meter, 0},
+				s:  Metric,
+			},
+			want: "" +
+				"  1.500000m\n" +
+				"  0.250000m\n" +
+				"-12.250000m\n" +
+				"  0.000000m\n",
+		},
+		{
+			name: "Imperial",
+			args: args{
+				ds: []Distance{6 * Inch, 2 * Feet},
+				s:  Imperial,
+			},
+			want: "" +
+				"0.500000ft\n" +
+				"2.000000ft\n",
+		},
+		{
+			name: "Empty",
+			args: args{
+				ds: nil,
+				s:  Metric,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTable(tt.args.ds, tt.args.s); got != tt.want {
+				t.Errorf("FormatTable() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMeasurement(t *testing.T) {
+	type args struct {
+		value       Distance
+		uncertainty Distance
+		s           System
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "One Significant Figure",
+			args: args{value: 2.5 * Meter, uncertainty: 3 * Centimeter, s: Metric},
+			want: "2.50 ± 0.03 m",
+		},
+		{
+			name: "Rounds Up A Digit",
+			args: args{value: 2.5 * Meter, uncertainty: 9.6 * Centimeter, s: Metric},
+			want: "2.5 ± 0.1 m",
+		},
+		{
+			name: "Leading One",
+			args: args{value: 2.5 * Meter, uncertainty: 12 * Centimeter, s: Metric},
+			want: "2.50 ± 0.12 m",
+		},
+		{
+			name: "Rounded Value",
+			args: args{value: 12.3456 * Millimeter, uncertainty: 0.2 * Millimeter, s: Metric},
+			want: "1.23 ± 0.02 cm",
+		},
+		{
+			name: "Large Uncertainty",
+			args: args{value: 250 * Meter, uncertainty: 30 * Meter, s: Metric},
+			want: "250 ± 30 m",
+		},
+		{
+			name: "Uncertainty Larger Than Value",
+			args: args{value: 7 * Millimeter, uncertainty: 2 * Centimeter, s: Metric},
+			want: "1 ± 2 cm",
+		},
+		{
+			name: "Negative Uncertainty",
+			args: args{value: -2 * Feet, uncertainty: -1 * Inch, s: Imperial},
+			want: "-2.00 ± 0.08 ft",
+		},
+		{
+			name: "Zero Uncertainty",
+			args: args{value: 2 * Meter, uncertainty: 0, s: Metric},
+			want: "2.000000 ± 0.000000 m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatMeasurement(tt.args.value, tt.args.uncertainty, tt.args.s); got != tt.want {
+				t.Errorf("FormatMeasurement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_Display(t *testing.T) {
+	type args struct {
+		s       System
+		roundTo Distance
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want string
+	}{
+		{
+			name: "Centimeters In Meters",
+			d:    100 * Inch,
+			args: args{s: Metric, roundTo: Centimeter},
+			want: "2.54m",
+		},
+		{
+			name: "Millimeters In Centimeters",
+			d:    12.345 * Millimeter,
+			args: args{s: Metric, roundTo: Millimeter},
+			want: "1.2cm",
+		},
+		{
+			name: "Rounds Up To Next Unit",
+			d:    99.6 * Centimeter,
+			args: args{s: Metric, roundTo: Centimeter},
+			want: "1.00m",
+		},
+		{
+			name: "Coarser Than Unit",
+			d:    234 * Meter,
+			args: args{s: Metric, roundTo: 100 * Meter},
+			want: "200m",
+		},
+		{
+			name: "Inches In Feet",
+			d:    2*Feet + 7*Inch,
+			args: args{s: Imperial, roundTo: 6 * Inch},
+			want: "2.5ft",
+		},
+		{
+			name: "Negative",
+			d:    -1.006 * Meter,
+			args: args{s: Metric, roundTo: Centimeter},
+			want: "-1.01m",
+		},
+		{
+			name: "Rounds To Zero",
+			d:    4 * Millimeter,
+			args: args{s: Metric, roundTo: Centimeter},
+			want: "0m",
+		},
+		{
+			name: "Inches In Meters",
+			d:    40 * Inch,
+			args: args{s: Metric, roundTo: Inch},
+			want: "1.0160m",
+		},
+		{
+			name: "Next Inch In Meters",
+			d:    41.2 * Inch,
+			args: args{s: Metric, roundTo: Inch},
+			want: "1.0414m",
+		},
+		{
+			name: "Inches In Yards",
+			d:    40 * Inch,
+			args: args{s: Imperial, roundTo: Inch},
+			want: "1.11yd",
+		},
+		{
+			name: "No Rounding",
+			d:    1.25 * Meter,
+			args: args{s: Metric, roundTo: 0},
+			want: "1.250000m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Display(tt.args.s, tt.args.roundTo); got != tt.want {
+				t.Errorf("Distance.Display() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRange(t *testing.T) {
+	type args struct {
+		lo Distance
+		hi Distance
+		s  System
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Same Unit",
+			args: args{lo: Meter, hi: 1.5 * Meter, s: Metric},
+			want: "1.000000–1.500000m",
+		},
+		{
+			name: "Spanning Units",
+			args: args{lo: 50 * Centimeter, hi: 2 * Meter, s: Metric},
+			want: "0.500000–2.000000m",
+		},
+		{
+			name: "Swapped",
+			args: args{lo: 2 * Feet, hi: 6 * Inch, s: Imperial},
+			want: "0.500000–2.000000ft",
+		},
+		{
+			name: "Negative",
+			args: args{lo: -2 * Centimeter, hi: 5 * Millimeter, s: Metric},
+			want: "-2.000000–0.500000cm",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatRange(tt.args.lo, tt.args.hi, tt.args.s); got != tt.want {
+				t.Errorf("FormatRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatAuto(t *testing.T) {
+	type args struct {
+		s System
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want string
+	}{
+		{
+			name: "Just Below A Meter",
+			d:    0.998 * Meter,
+			args: args{s: Metric},
+			want: "1.00m",
+		},
+		{
+			name: "Not Close Enough",
+			d:    0.994 * Meter,
+			args: args{s: Metric},
+			want: "99.40cm",
+		},
+		{
+			name: "Just Below A Millimeter",
+			d:    999.6 * Micrometer,
+			args: args{s: Metric},
+			want: "1.00mm",
+		},
+		{
+			name: "Just Below A Foot",
+			d:    11.95 * Inch,
+			args: args{s: Imperial},
+			want: "1.00ft",
+		},
+		{
+			name: "Negative",
+			d:    -0.999 * Yard,
+			args: args{s: Imperial},
+			want: "-1.00yd",
+		},
+		{
+			name: "Well Within A Unit",
+			d:    2.5 * Meter,
+			args: args{s: Metric},
+			want: "2.50m",
+		},
+		{
+			name: "Zero",
+			d:    0,
+			args: args{s: Metric},
+			want: "0m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.FormatAuto(tt.args.s); got != tt.want {
+				t.Errorf("Distance.FormatAuto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendDistance(t *testing.T) {
+	ds := []Distance{0, 2 * Nanometer, 2.5 * Micrometer, 25 * Millimeter, -2 * Meter, 12.345 * Kilometer, 5*Feet + 11*Inch, 2 * Lightyear}
+	for _, s := range []System{Metric, Imperial} {
+		for _, d := range ds {
+			if s == Metric {
+				UseMetric()
+			} else {
+				UseImperial()
+			}
+			want := d.String()
+			got := AppendDistance([]byte("d="), d, s, 6)
+			if string(got) != "d="+want {
+				t.Errorf("AppendDistance() = %q, want %q", got, "d="+want)
+			}
+		}
+	}
+	UseMetric()
+	if got, want := string(AppendDistance(nil, 1.5*Meter, Metric, -1)), "1.5m"; got != want {
+		t.Errorf("AppendDistance() = %q, want %q", got, want)
+	}
+
+	// Rounding that carries into the next unit prints in that unit.
+	carries := []struct {
+		d    Distance
+		s    System
+		prec int
+		want string
+	}{
+		{d: 999.9999999 * Meter, s: Metric, prec: 6, want: "1.000000km"},
+		{d: -999.96 * Millimeter, s: Metric, prec: 1, want: "-1.0m"},
+		{d: 1759.9999999 * Yard, s: Imperial, prec: 6, want: "1.000000mi"},
+		{d: 999.4 * Meter, s: Metric, prec: 0, want: "999m"},
+	}
+	for _, c := range carries {
+		if got := string(AppendDistance([]byte("d="), c.d, c.s, c.prec)); got != "d="+c.want {
+			t.Errorf("AppendDistance(%v) = %q, want %q", float64(c.d), got, "d="+c.want)
+		}
+	}
+}
+
+func BenchmarkAppendDistance(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendDistance(buf[:0], 12.345*Kilometer, Metric, 3)
+	}
+}
+
+func BenchmarkDistance_String(b *testing.B) {
+	d := 12.345 * Kilometer
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func TestDistance_PreferredString(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "Micrometers", in: "0.0000015m", want: "1.5µm"},
+		{name: "Whole Micrometers", in: "0.00002m", want: "20µm"},
+		{name: "Sub Millimeter", in: "0.00025m", want: "250µm"},
+		{name: "Nanometers", in: "0.0000000035m", want: "3.5nm"},
+		{name: "Millimeters", in: "0.0042m", want: "4.2mm"},
+		{name: "Negative", in: "-0.0000015m", want: "-1.5µm"},
+		{name: "Meters", in: "12m", want: "12m"},
+		{name: "Rounds Up A Unit", in: "999.9999999m", want: "1km"},
+		{name: "Negative Rounds Up A Unit", in: "-0.9999999999mm", want: "-1mm"},
+		{name: "Zero", in: "0m", want: "0m"},
+	}
+	UseMetric()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDistance(tt.in)
+			if err != nil {
+				t.Fatalf("ParseDistance(%q) error = %v", tt.in, err)
+			}
+			if got := d.PreferredString(); got != tt.want {
+				t.Errorf("Distance.PreferredString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_Quote(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		want string
+	}{
+		{name: "Zero", d: 0, want: `"0nm"`},
+		{name: "Fraction", d: 0.25 * Nanometer, want: `"0.25nm"`},
+		{name: "Millimeter", d: 1.5 * Millimeter, want: `"1.5e6nm"`},
+		{name: "Negative", d: -12 * Nanometer, want: `"-12nm"`},
+		{name: "Tiny", d: 1e-7 * Nanometer, want: `"1e-7nm"`},
+		{name: "Inexact", d: Distance(math.Nextafter(3e8, 4e8)), want: `"3.0000000000000006e8nm"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Quote(); got != tt.want {
+				t.Errorf("Distance.Quote() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_QuoteRoundTrip(t *testing.T) {
+	ds := []Distance{
+		0,
+		Nanometer,
+		1.0 / 3 * Nanometer,
+		2.54 * Centimeter,
+		100.5 * Yard,
+		-1.9 * Mile,
+		Distance(math.Nextafter(float64(Meter), math.Inf(1))),
+		1234567.891011 * Kilometer,
+		5e-300,
+		2 * Lightyear,
+		Distance(math.MaxFloat64),
+	}
+	for _, d := range ds {
+		q := d.Quote()
+		s, err := strconv.Unquote(q)
+		if err != nil {
+			t.Fatalf("strconv.Unquote(%v) error = %v", q, err)
+		}
+		got, err := ParseDistance(s)
+		if err != nil {
+			t.Fatalf("ParseDistance(%q) error = %v", s, err)
+		}
+		if got != d {
+			t.Errorf("ParseDistance(%q) = %v, want exactly %v", s, float64(got), float64(d))
+		}
+	}
+}
+
+func TestDistance_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		system System
+		format string
+		d      Distance
+		want   string
+	}{
+		{name: "Default", system: Metric, format: "%v", d: 2.5 * Meter, want: "2.500000m"},
+		{name: "String Verb", system: Metric, format: "%s", d: 2.5 * Meter, want: "2.500000m"},
+		{name: "Precision", system: Metric, format: "%.1v", d: 2.54 * Meter, want: "2.5m"},
+		{name: "Float", system: Metric, format: "%f", d: 25 * Millimeter, want: "2.500000cm"},
+		{name: "Float Precision", system: Metric, format: "%.2f", d: 1234 * Millimeter, want: "1.23m"},
+		{name: "Width", system: Metric, format: "%8.2f", d: 1.5 * Meter, want: "   1.50m"},
+		{name: "Left Justified", system: Metric, format: "%-8.2f|", d: 1.5 * Meter, want: "1.50m   |"},
+		{name: "Micrometer Width", system: Metric, format: "%6.1f", d: 1.5 * Micrometer, want: " 1.5µm"},
+		{name: "Plus", system: Metric, format: "%+.1f", d: Meter, want: "+1.0m"},
+		{name: "Plus Negative", system: Metric, format: "%+.1f", d: -Meter, want: "-1.0m"},
+		{name: "Plus Infinity", system: Metric, format: "%+v", d: Distance(math.Inf(1)), want: "+Infpc"},
+		{name: "Plus Negative Infinity", system: Metric, format: "%+v", d: Distance(math.Inf(-1)), want: "-Infpc"},
+		{name: "Shortest", system: Metric, format: "%g", d: 1.25 * Meter, want: "1.25m"},
+		{name: "Significant Digits", system: Metric, format: "%.2g", d: 1.25 * Meter, want: "1.2m"},
+		{name: "Whole", system: Metric, format: "%d", d: 2.6 * Meter, want: "3m"},
+		{name: "Quoted", system: Metric, format: "%q", d: Millimeter, want: `"1e6nm"`},
+		{name: "Go Syntax", system: Metric, format: "%#v", d: Meter, want: "1e+09"},
+		{name: "Bad Verb", system: Metric, format: "%x", d: Meter, want: "%!x(length.Distance=1.000000m)"},
+		{name: "Imperial Default", system: Imperial, format: "%v", d: 3 * Feet, want: "1.000000yd"},
+		{name: "Imperial Precision", system: Imperial, format: "%.2v", d: 18 * Inch, want: "1.50ft"},
+		{name: "Imperial Width", system: Imperial, format: "%7.1f", d: 5 * Inch, want: "  5.0in"},
+		{name: "Imperial Shortest", system: Imperial, format: "%g", d: 2 * Mile, want: "2mi"},
+		{name: "Imperial Left Justified", system: Imperial, format: "%-6.0f|", d: 2 * Yard, want: "2yd   |"},
+	}
+	defer UseMetric()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.system == Metric {
+				UseMetric()
+			} else {
+				UseImperial()
+			}
+			if got := fmt.Sprintf(tt.format, tt.d); got != tt.want {
+				t.Errorf("fmt.Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatSigFigs(t *testing.T) {
+	type args struct {
+		n int
+		s System
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want string
+	}{
+		{name: "Three Of Thousands", d: 1234 * Millimeter, args: args{n: 3, s: Metric}, want: "1.23m"},
+		{name: "Two Of Large", d: 1234 * Meter, args: args{n: 2, s: Metric}, want: "1.2km"},
+		{name: "Exact Width", d: 1234 * Meter, args: args{n: 4, s: Metric}, want: "1.234km"},
+		{name: "Trailing Zeros Kept", d: 1.5 * Meter, args: args{n: 4, s: Metric}, want: "1.500m"},
+		{name: "Whole Trailing Zero", d: 20 * Centimeter, args: args{n: 3, s: Metric}, want: "20.0cm"},
+		{name: "Rounds Up A Digit", d: 9.996 * Meter, args: args{n: 3, s: Metric}, want: "10.0m"},
+		{name: "Rounds Up A Unit", d: 999.6 * Meter, args: args{n: 3, s: Metric}, want: "1.00km"},
+		{name: "Negative Rounds Up A Unit", d: -99.97 * Centimeter, args: args{n: 2, s: Metric}, want: "-1.0m"},
+		{name: "Imperial Rounds Up A Unit", d: 1759.6 * Yard, args: args{n: 3, s: Imperial}, want: "1.00mi"},
+		{name: "One Figure", d: 2.7 * Micrometer, args: args{n: 1, s: Metric}, want: "3µm"},
+		{name: "Zero Treated As One", d: 2.7 * Micrometer, args: args{n: 0, s: Metric}, want: "3µm"},
+		{name: "Many Figures", d: 1.0 / 3 * Meter, args: args{n: 6, s: Metric}, want: "33.3333cm"},
+		{name: "Negative", d: -45.67 * Millimeter, args: args{n: 2, s: Metric}, want: "-4.6cm"},
+		{name: "Zero", d: 0, args: args{n: 3, s: Metric}, want: "0.00m"},
+		{name: "Imperial", d: 100.5 * Yard, args: args{n: 2, s: Imperial}, want: "100yd"},
+		{name: "No Exponent", d: 12345 * Mile, args: args{n: 2, s: Imperial}, want: "12000mi"},
+		{name: "Imperial Fraction", d: 7.25 * Inch, args: args{n: 5, s: Imperial}, want: "7.2500in"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.FormatSigFigs(tt.args.n, tt.args.s); got != tt.want {
+				t.Errorf("Distance.FormatSigFigs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKilometersMeters_Format(t *testing.T) {
+	tests := []struct {
+		name string
+		f    KilometersMeters
+		d    Distance
+		want string
+	}{
+		{name: "Mixed", f: KilometersMeters{}, d: 1250 * Meter, want: "1 km 250 m"},
+		{name: "Exact Kilometers", f: KilometersMeters{}, d: 2 * Kilometer, want: "2 km"},
+		{name: "Sub Kilometer", f: KilometersMeters{}, d: 250 * Meter, want: "250 m"},
+		{name: "Zero", f: KilometersMeters{}, d: 0, want: "0 m"},
+		{name: "Negative", f: KilometersMeters{}, d: -1250 * Meter, want: "-1 km 250 m"},
+		{name: "Rounds To Meter", f: KilometersMeters{}, d: 42195.4 * Meter, want: "42 km 195 m"},
+		{name: "Rounds Into Kilometers", f: KilometersMeters{}, d: 2999.6 * Meter, want: "3 km"},
+		{name: "Decimals", f: KilometersMeters{Decimals: 2}, d: 1250.75 * Meter, want: "1 km 250.75 m"},
+		{name: "Infinity", f: KilometersMeters{}, d: Distance(math.Inf(1)), want: "+Inf km"},
+		{name: "Negative Infinity", f: KilometersMeters{Decimals: 2}, d: Distance(math.Inf(-1)), want: "-Inf km"},
+		{name: "NaN", f: KilometersMeters{}, d: Distance(math.NaN()), want: "NaN m"},
+		{name: "Decimals Padded", f: KilometersMeters{Decimals: 1}, d: 1005 * Meter, want: "1 km 5.0 m"},
+		{name: "Decimals Sub Meter", f: KilometersMeters{Decimals: 3}, d: 25 * Centimeter, want: "0.250 m"},
+		{name: "Negative Decimals", f: KilometersMeters{Decimals: -1}, d: 1250.75 * Meter, want: "1 km 251 m"},
+		{name: "Imperial Mile", f: KilometersMeters{}, d: Mile, want: "1 km 609 m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Format(tt.d); got != tt.want {
+				t.Errorf("KilometersMeters.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatKmM(t *testing.T) {
+	if got, want := (10500 * Meter).FormatKmM(), "10 km 500 m"; got != want {
+		t.Errorf("Distance.FormatKmM() = %v, want %v", got, want)
+	}
+}
+
+func TestDistance_Compact(t *testing.T) {
+	tests := []struct {
+		name   string
+		system System
+		d      Distance
+		want   string
+	}{
+		{name: "Whole", system: Metric, d: 2 * Meter, want: "2m"},
+		{name: "Fraction", system: Metric, d: 1.5 * Meter, want: "1.5m"},
+		{name: "Zero", system: Metric, d: 0, want: "0m"},
+		{name: "Small", system: Metric, d: 1.5 * Micrometer, want: "1.5µm"},
+		{name: "Trailing Zeros", system: Metric, d: 120 * Meter, want: "120m"},
+		{name: "Negative", system: Metric, d: -25 * Centimeter, want: "-25cm"},
+		{name: "Third", system: Metric, d: 1.0 / 3 * Meter, want: "33.33333333333333cm"},
+		{name: "Feet", system: Imperial, d: 1.5 * Feet, want: "1.5ft"},
+		{name: "Yards", system: Imperial, d: 100.5 * Yard, want: "100.5yd"},
+	}
+	defer UseMetric()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.system == Metric {
+				UseMetric()
+			} else {
+				UseImperial()
+			}
+			if got := tt.d.Compact(); got != tt.want {
+				t.Errorf("Distance.Compact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_CompactRoundTrip(t *testing.T) {
+	ds := []Distance{
+		Nanometer,
+		0.1 * Nanometer,
+		1.0 / 3 * Meter,
+		2.54 * Centimeter,
+		100.5 * Yard,
+		-1.9 * Mile,
+		5*Feet + 11*Inch,
+		Distance(math.Nextafter(float64(Meter), 0)),
+		123456.789 * Kilometer,
+		AstronomicalUnit,
+		3.26 * Parsec,
+	}
+	defer UseMetric()
+	for _, s := range []System{Metric, Imperial} {
+		if s == Metric {
+			UseMetric()
+		} else {
+			UseImperial()
+		}
+		for _, d := range ds {
+			c := d.Compact()
+			got, err := ParseDistance(c)
+			if err != nil {
+				t.Fatalf("ParseDistance(%q) error = %v", c, err)
+			}
+			if got != d {
+				t.Errorf("ParseDistance(%q) = %v, want exactly %v", c, float64(got), float64(d))
+			}
+		}
+	}
+}
+
+func TestDistance_FormatTrimmed(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		s    System
+		want string
+	}{
+		{name: "Whole Meters", d: 2 * Meter, s: Metric, want: "2m"},
+		{name: "Half Meter", d: 2.5 * Meter, s: Metric, want: "2.5m"},
+		{name: "Centimeters", d: 25 * Centimeter, s: Metric, want: "25cm"},
+		{name: "Fraction Of Centimeter", d: 12.75 * Millimeter, s: Metric, want: "1.275cm"},
+		{name: "Zero", d: 0, s: Metric, want: "0m"},
+		{name: "Negative", d: -1.5 * Micrometer, s: Metric, want: "-1.5µm"},
+		{name: "Third", d: 1.0 / 3 * Meter, s: Metric, want: "33.33333333333333cm"},
+		{name: "Whole Yards", d: 100 * Yard, s: Imperial, want: "100yd"},
+		{name: "Half Yard", d: 100.5 * Yard, s: Imperial, want: "100.5yd"},
+		{name: "Inches", d: 6 * Inch, s: Imperial, want: "6in"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.FormatTrimmed(tt.s); got != tt.want {
+				t.Errorf("Distance.FormatTrimmed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package length
+
+import "math"
+
+// Radius returns the radius of a circle or sphere with the given diameter.
+func Radius(diameter Distance) Distance {
+	return diameter / 2
+}
+
+// Diameter returns the diameter of a circle or sphere with the given radius.
+func Diameter(radius Distance) Distance {
+	return radius * 2
+}
+
+// SphereSurfaceArea returns the surface area of a sphere with the given radius.
+func SphereSurfaceArea(radius Distance) Area {
+	r := float64(radius)
+	return Area(4 * math.Pi * r * r)
+}
+
+// SphereVolume returns the volume of a sphere with the given radius.
+func SphereVolume(radius Distance) Volume {
+	r := float64(radius)
+	return Volume(4.0 / 3 * math.Pi * r * r * r)
+}
+
+// A Point is a position on a flat plane, given by its distances
+// east (X) and north (Y) of an origin.
+type Point struct {
+	X, Y Distance
+}
+
+// Components splits a distance d travelled along a compass bearing
+// into its east and north components. Bearings are in degrees measured
+// clockwise from north, so 0 is north, 90 is east, 180 is south and
+// 270 is west.
+func Components(bearingDeg float64, d Distance) (east, north Distance) {
+	sin, cos := math.Sincos(bearingDeg * math.Pi / 180)
+	return Distance(sin) * d, Distance(cos) * d
+}
+
+// Move returns the point reached by travelling the distance d from p
+// along the compass bearing bearingDeg, as described for Components.
+// A negative d travels in the opposite direction.
+func (p Point) Move(bearingDeg float64, d Distance) Point {
+	east, north := Components(bearingDeg, d)
+	return Point{X: p.X + east, Y: p.Y + north}
+}
+
+// EarthRadius is the mean radius of the Earth, as defined by the
+// International Union of Geodesy and Geophysics, used by Haversine.
+const EarthRadius = 6371.0088 * Kilometer
+
+// Haversine returns the great-circle distance between two points on the
+// Earth given by their latitudes and longitudes in degrees, treating the
+// Earth as a sphere of radius EarthRadius. The result is within about
+// 0.5% of the distance on the real, slightly flattened, Earth.
+func Haversine(lat1, lon1, lat2, lon2 float64) Distance {
+	return HaversineRadius(lat1, lon1, lat2, lon2, EarthRadius)
+}
+
+// HaversineRadius is like Haversine, but for a sphere of the given radius.
+func HaversineRadius(lat1, lon1, lat2, lon2 float64, radius Distance) Distance {
+	const rad = math.Pi / 180
+	phi1, phi2 := lat1*rad, lat2*rad
+	dphi, dlambda := (lat2-lat1)*rad, (lon2-lon1)*rad
+	sinPhi, sinLambda := math.Sin(dphi/2), math.Sin(dlambda/2)
+	h := sinPhi*sinPhi + math.Cos(phi1)*math.Cos(phi2)*sinLambda*sinLambda
+	// Rounding can push h just past one for antipodal points.
+	h = math.Min(h, 1)
+	return Distance(2*math.Asin(math.Sqrt(h))) * radius
+}
@@ -0,0 +1,199 @@
+package length
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRadius(t *testing.T) {
+	if got, want := Radius(3*Meter), 1.5*Meter; got != want {
+		t.Errorf("Radius() = %v, want %v", got, want)
+	}
+}
+
+func TestDiameter(t *testing.T) {
+	if got, want := Diameter(1.5*Meter), 3*Meter; got != want {
+		t.Errorf("Diameter() = %v, want %v", got, want)
+	}
+}
+
+func TestSphereSurfaceArea(t *testing.T) {
+	tests := []struct {
+		name   string
+		radius Distance
+		want   float64 // square meters
+	}{
+		{
+			name:   "Unit Sphere",
+			radius: Meter,
+			want:   4 * math.Pi,
+		},
+		{
+			name:   "Unit Diameter",
+			radius: Radius(Meter),
+			want:   math.Pi,
+		},
+		{
+			name:   "Zero",
+			radius: 0,
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := float64(SphereSurfaceArea(tt.radius)) / float64(Meter*Meter)
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("SphereSurfaceArea() = %vm², want %vm²", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSphereVolume(t *testing.T) {
+	tests := []struct {
+		name   string
+		radius Distance
+		want   float64 // cubic meters
+	}{
+		{
+			name:   "Unit Sphere",
+			radius: Meter,
+			want:   4.0 / 3 * math.Pi,
+		},
+		{
+			name:   "Unit Diameter",
+			radius: Radius(Meter),
+			want:   math.Pi / 6,
+		},
+		{
+			name:   "Zero",
+			radius: 0,
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := float64(SphereVolume(tt.radius)) / float64(Meter*Meter*Meter)
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("SphereVolume() = %vm³, want %vm³", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPoint_Move(t *testing.T) {
+	type args struct {
+		bearingDeg float64
+		d          Distance
+	}
+	tests := []struct {
+		name string
+		p    Point
+		args args
+		want Point
+	}{
+		{
+			name: "North",
+			p:    Point{},
+			args: args{bearingDeg: 0, d: 10 * Meter},
+			want: Point{X: 0, Y: 10 * Meter},
+		},
+		{
+			name: "East",
+			p:    Point{},
+			args: args{bearingDeg: 90, d: 10 * Meter},
+			want: Point{X: 10 * Meter, Y: 0},
+		},
+		{
+			name: "South",
+			p:    Point{X: Meter, Y: Meter},
+			args: args{bearingDeg: 180, d: 3 * Meter},
+			want: Point{X: Meter, Y: -2 * Meter},
+		},
+		{
+			name: "West",
+			p:    Point{},
+			args: args{bearingDeg: 270, d: Kilometer},
+			want: Point{X: -Kilometer, Y: 0},
+		},
+		{
+			name: "Northeast",
+			p:    Point{},
+			args: args{bearingDeg: 45, d: Distance(math.Sqrt2) * Meter},
+			want: Point{X: Meter, Y: Meter},
+		},
+		{
+			name: "Full Turn",
+			p:    Point{},
+			args: args{bearingDeg: 360 + 90, d: 5 * Meter},
+			want: Point{X: 5 * Meter, Y: 0},
+		},
+		{
+			name: "Negative Distance",
+			p:    Point{},
+			args: args{bearingDeg: 0, d: -5 * Meter},
+			want: Point{X: 0, Y: -5 * Meter},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.p.Move(tt.args.bearingDeg, tt.args.d)
+			if math.Abs(float64(got.X-tt.want.X)) > 1e-3 || math.Abs(float64(got.Y-tt.want.Y)) > 1e-3 {
+				t.Errorf("Point.Move() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	type args struct {
+		lat1, lon1, lat2, lon2 float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Distance
+	}{
+		{
+			name: "Same Point",
+			args: args{lat1: 51.5074, lon1: -0.1278, lat2: 51.5074, lon2: -0.1278},
+			want: 0,
+		},
+		{
+			name: "London To Paris",
+			args: args{lat1: 51.5074, lon1: -0.1278, lat2: 48.8566, lon2: 2.3522},
+			want: 343.557 * Kilometer,
+		},
+		{
+			name: "New York To Los Angeles",
+			args: args{lat1: 40.7128, lon1: -74.0060, lat2: 34.0522, lon2: -118.2437},
+			want: 3935.752 * Kilometer,
+		},
+		{
+			name: "Sydney To London",
+			args: args{lat1: -33.8688, lon1: 151.2093, lat2: 51.5074, lon2: -0.1278},
+			want: 16993.957 * Kilometer,
+		},
+		{
+			name: "Antipodes",
+			args: args{lat1: 0, lon1: 0, lat2: 0, lon2: 180},
+			want: math.Pi * EarthRadius,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Haversine(tt.args.lat1, tt.args.lon1, tt.args.lat2, tt.args.lon2)
+			if math.Abs(float64(got-tt.want)) > float64(Meter) {
+				t.Errorf("Haversine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHaversineRadius(t *testing.T) {
+	// A quarter of the way around a unit sphere.
+	got := HaversineRadius(0, 0, 90, 0, Meter)
+	if want := Distance(math.Pi/2) * Meter; math.Abs(float64(got-want)) > 1e-6 {
+		t.Errorf("HaversineRadius() = %v, want %v", got, want)
+	}
+}
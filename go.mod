@@ -1 +1,3 @@
 module github.com/penguingovernor/length
+
+go 1.23
@@ -0,0 +1,163 @@
+package length
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync/atomic"
+)
+
+// A JSONFormat selects how distances are encoded to JSON.
+type JSONFormat int
+
+const (
+	// JSONNumber encodes a distance as a bare number of nanometers,
+	// such as 1500000000.
+	JSONNumber JSONFormat = iota
+	// JSONNanometers encodes a distance as an object holding its
+	// nanometer count under a fixed key, such as {"nm":1500000000},
+	// for front-ends that do their own unit conversion.
+	JSONNanometers
+	// JSONValueUnit encodes a distance as a Quantity object holding
+	// its number of the unit set by SetJSONUnit, such as
+	// {"value":1.5,"unit":"m"}.
+	JSONValueUnit
+)
+
+// jsonFormat holds the format used by MarshalJSON. It is atomic so that
+// SetJSONFormat may be called while other goroutines encode distances.
+var jsonFormat atomic.Int32
+
+func init() {
+	SetJSONFormat(JSONValueUnit)
+	jsonUnit.Store(&namedUnit{Meter, "m"})
+}
+
+// jsonUnit holds the unit used by JSONValueUnit. It is atomic, like
+// jsonFormat, so that SetJSONUnit may be called while encoding.
+var jsonUnit atomic.Pointer[namedUnit]
+
+// SetJSONFormat sets the format that MarshalJSON uses to encode distances.
+// By default distances are encoded with JSONValueUnit.
+// UnmarshalJSON accepts every format regardless of this setting.
+// It is safe to call SetJSONFormat concurrently with MarshalJSON.
+func SetJSONFormat(f JSONFormat) {
+	jsonFormat.Store(int32(f))
+}
+
+// SetJSONUnit sets the unit in which the JSONValueUnit format encodes
+// distances, given by its suffix, such as "yd" for {"value":100.5,"unit":"yd"}.
+// By default distances are encoded in meters.
+// An error is returned if the unit is unknown.
+// It is safe to call SetJSONUnit concurrently with MarshalJSON.
+func SetJSONUnit(unit string) error {
+	u, err := lookupUnit(unit)
+	if err != nil {
+		return err
+	}
+	jsonUnit.Store(&u)
+	return nil
+}
+
+// jsonObject holds the fields of every object format accepted by
+// UnmarshalJSON.
+type jsonObject struct {
+	NM    *float64 `json:"nm,omitempty"`
+	Value *float64 `json:"value,omitempty"`
+	Unit  *string  `json:"unit,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The encoding is selected by SetJSONFormat. JSONNumber and JSONNanometers
+// hold the exact nanometer count, so decoding the result gives back the
+// same distance; JSONValueUnit may round in the conversion to its unit.
+func (d Distance) MarshalJSON() ([]byte, error) {
+	nm := float64(d)
+	switch JSONFormat(jsonFormat.Load()) {
+	case JSONNanometers:
+		return json.Marshal(jsonObject{NM: &nm})
+	case JSONValueUnit:
+		u := jsonUnit.Load()
+		return json.Marshal(Quantity{
+			Value: float64(d) / float64(u.unit),
+			Unit:  u.symbol,
+		})
+	}
+	return json.Marshal(nm)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts every format that MarshalJSON produces, whichever was used
+// to encode the distance, as well as a string such as "100.5yd" that is
+// parsed with ParseDistance; see UnmarshalFlexible. An object with an
+// unknown unit is an error. As usual for JSON, null leaves d unchanged.
+func (d *Distance) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	v, err := UnmarshalFlexible(data)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// UnmarshalFlexible parses a distance in any of the forms in which
+// distances are commonly stored, so that values from JSON, TOML,
+// environment variables or flags can share one entry point.
+// After surrounding white space is removed, the form is detected in
+// this order:
+//
+//  1. an object, starting with '{', holding either the nanometer count
+//     under "nm", such as {"nm":1500}, or a Quantity, such as
+//     {"value":100.5,"unit":"yd"};
+//  2. a double-quoted JSON string holding a distance string for
+//     ParseDistance, such as "100.5yd";
+//  3. a bare number, such as 1500 or 1.5e3, which is a count of
+//     nanometers;
+//  4. anything else, which is parsed as a distance string with
+//     ParseDistance, such as 100.5yd.
+func UnmarshalFlexible(data []byte) (Distance, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return 0, errors.New("length: empty distance")
+	}
+	switch data[0] {
+	case '{':
+		var obj jsonObject
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return 0, err
+		}
+		switch {
+		case obj.NM != nil:
+			return Distance(*obj.NM), nil
+		case obj.Value != nil && obj.Unit != nil:
+			return Quantity{Value: *obj.Value, Unit: *obj.Unit}.Distance()
+		}
+		return 0, errors.New("length: distance object " + string(data) + " needs an nm field or value and unit fields")
+	case '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, err
+		}
+		return ParseDistance(s)
+	}
+	if isNumber(data) {
+		nm, err := strconv.ParseFloat(string(data), 64)
+		if err == nil {
+			return Distance(nm), nil
+		}
+	}
+	return ParseDistance(string(data))
+}
+
+// isNumber reports whether b looks like a plain decimal number,
+// starting with a sign, digit or point and ending with a digit or point,
+// as opposed to a distance string with a unit or the words Inf and NaN.
+func isNumber(b []byte) bool {
+	first, last := b[0], b[len(b)-1]
+	return (first == '-' || first == '+' || first == '.' || isDigit(first)) &&
+		(last == '.' || isDigit(last))
+}
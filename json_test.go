@@ -0,0 +1,304 @@
+package length
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+func TestDistance_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		format JSONFormat
+		d      Distance
+		want   string
+	}{
+		{
+			name:   "Number",
+			format: JSONNumber,
+			d:      1.5 * Meter,
+			want:   "1500000000",
+		},
+		{
+			name:   "Value And Unit",
+			format: JSONValueUnit,
+			d:      1.5 * Meter,
+			want:   `{"value":1.5,"unit":"m"}`,
+		},
+		{
+			name:   "Value And Unit Zero",
+			format: JSONValueUnit,
+			d:      0,
+			want:   `{"value":0,"unit":"m"}`,
+		},
+		{
+			name:   "Value And Unit Negative",
+			format: JSONValueUnit,
+			d:      -25 * Centimeter,
+			want:   `{"value":-0.25,"unit":"m"}`,
+		},
+		{
+			name:   "Nanometers",
+			format: JSONNanometers,
+			d:      Millimeter,
+			want:   `{"nm":1000000}`,
+		},
+		{
+			name:   "Nanometers Negative",
+			format: JSONNanometers,
+			d:      -3 * Nanometer,
+			want:   `{"nm":-3}`,
+		},
+		{
+			name:   "Nanometers Large",
+			format: JSONNanometers,
+			d:      2 * Lightyear,
+			want:   `{"nm":1.8922e+25}`,
+		},
+	}
+	defer SetJSONFormat(JSONValueUnit)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetJSONFormat(tt.format)
+			got, err := json.Marshal(tt.d)
+			if err != nil {
+				t.Fatalf("Distance.MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Distance.MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name: "Number",
+			data: "1500000000",
+			want: 1.5 * Meter,
+		},
+		{
+			name: "Nanometers",
+			data: `{"nm":1000000}`,
+			want: Millimeter,
+		},
+		{
+			name: "Nanometers With Spaces",
+			data: ` { "nm" : 2.5 } `,
+			want: 2.5 * Nanometer,
+		},
+		{
+			name:    "Missing Key",
+			data:    `{"m":1}`,
+			wantErr: true,
+		},
+		{
+			name: "Value And Unit",
+			data: `{"value":100.5,"unit":"yd"}`,
+			want: 100.5 * Yard,
+		},
+		{
+			name: "Value And Unit Zero",
+			data: `{"value":0,"unit":"m"}`,
+			want: 0,
+		},
+		{
+			name: "Value And Unit Negative",
+			data: `{"value":-2.5,"unit":"km"}`,
+			want: -2.5 * Kilometer,
+		},
+		{
+			name:    "Unknown Unit",
+			data:    `{"value":1,"unit":"furlong"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Missing Unit",
+			data:    `{"value":1}`,
+			wantErr: true,
+		},
+		{
+			name: "String",
+			data: `"100.5yd"`,
+			want: 100.5 * Yard,
+		},
+		{
+			name: "String Negative",
+			data: `"-1.5m"`,
+			want: -1.5 * Meter,
+		},
+		{
+			name:    "String Unknown Unit",
+			data:    `"1furlong"`,
+			wantErr: true,
+		},
+		{
+			name:    "Bool",
+			data:    `true`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Distance
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distance.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance_JSONRoundTrip(t *testing.T) {
+	ds := []Distance{0, Nanometer, 0.1 * Nanometer, 1234.5678 * Meter, -Mile, 2 * Lightyear, 1e300}
+	defer SetJSONFormat(JSONValueUnit)
+	for _, format := range []JSONFormat{JSONNumber, JSONNanometers} {
+		SetJSONFormat(format)
+		for _, d := range ds {
+			data, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("Distance.MarshalJSON(%v) error = %v", d, err)
+			}
+			var got Distance
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Distance.UnmarshalJSON(%s) error = %v", data, err)
+			}
+			if got != d {
+				t.Errorf("round trip of %s = %v, want %v", data, float64(got), float64(d))
+			}
+		}
+	}
+}
+
+func TestSetJSONFormat_Concurrent(t *testing.T) {
+	defer SetJSONFormat(JSONValueUnit)
+	formats := []JSONFormat{JSONNumber, JSONNanometers, JSONValueUnit}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				SetJSONFormat(formats[j%len(formats)])
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				b, err := json.Marshal(Meter)
+				if err != nil {
+					t.Errorf("Distance.MarshalJSON() error = %v", err)
+					return
+				}
+				var d Distance
+				if err := json.Unmarshal(b, &d); err != nil || d != Meter {
+					t.Errorf("Distance.UnmarshalJSON(%s) = %v, %v, want %v", b, d, err, Meter)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSetJSONUnit(t *testing.T) {
+	defer SetJSONUnit("m")
+	if err := SetJSONUnit("furlong"); err == nil {
+		t.Errorf("SetJSONUnit() error = nil, want error for unknown unit")
+	}
+	if err := SetJSONUnit("yd"); err != nil {
+		t.Fatalf("SetJSONUnit() error = %v", err)
+	}
+	got, err := json.Marshal(100.5 * Yard)
+	if err != nil {
+		t.Fatalf("Distance.MarshalJSON() error = %v", err)
+	}
+	if want := `{"value":100.5,"unit":"yd"}`; string(got) != want {
+		t.Errorf("Distance.MarshalJSON() = %s, want %s", got, want)
+	}
+	var d Distance
+	if err := json.Unmarshal(got, &d); err != nil {
+		t.Fatalf("Distance.UnmarshalJSON() error = %v", err)
+	}
+	if d != 100.5*Yard {
+		t.Errorf("Distance.UnmarshalJSON() = %v, want %v", d, 100.5*Yard)
+	}
+}
+
+func TestUnmarshalFlexible(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Distance
+		wantErr bool
+	}{
+		{name: "Nanometer Object", data: `{"nm":1500}`, want: 1500 * Nanometer},
+		{name: "Quantity Object", data: `{"value":100.5,"unit":"yd"}`, want: 100.5 * Yard},
+		{name: "Quoted String", data: `"100.5yd"`, want: 100.5 * Yard},
+		{name: "Unquoted String", data: "100.5yd", want: 100.5 * Yard},
+		{name: "Bare Number", data: "1500", want: 1500 * Nanometer},
+		{name: "Bare Exponent", data: "1.5e3", want: 1500 * Nanometer},
+		{name: "Negative Number", data: "-2", want: -2 * Nanometer},
+		{name: "Surrounding Space", data: " \t2.5m\n", want: 2.5 * Meter},
+		{name: "Zero", data: "0", want: 0},
+		{name: "Empty", data: "  ", wantErr: true},
+		{name: "Unknown Unit In Object", data: `{"value":1,"unit":"furlong"}`, wantErr: true},
+		{name: "Empty Object", data: `{}`, wantErr: true},
+		{name: "Bad Quoted String", data: `"abc"`, wantErr: true},
+		{name: "Unterminated Quote", data: `"1m`, wantErr: true},
+		{name: "Infinity Word", data: "Inf", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalFlexible([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalFlexible() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalFlexible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetJSONUnit_Concurrent(t *testing.T) {
+	defer SetJSONUnit("m")
+	units := []string{"m", "yd", "km", "ft"}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if err := SetJSONUnit(units[j%len(units)]); err != nil {
+					t.Errorf("SetJSONUnit() error = %v", err)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				b, err := json.Marshal(Kilometer)
+				if err != nil {
+					t.Errorf("Distance.MarshalJSON() error = %v", err)
+					return
+				}
+				var d Distance
+				if err := json.Unmarshal(b, &d); err != nil || !d.Eq(Kilometer) {
+					t.Errorf("Distance.UnmarshalJSON(%s) = %v, %v, want %v", b, d, err, Kilometer)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -2,7 +2,12 @@ package length
 
 import (
 	"errors"
-	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
 )
 
 // A Distance represents a physical distance
@@ -22,21 +27,44 @@ type Distance float64
 //	fmt.Print(length.Distance(meters)*length.Meter) // prints 10m
 //
 const (
-	Nanometer  Distance = 1
-	Micrometer          = 1e3 * Nanometer
-	Millimeter          = 1e3 * Micrometer
-	Centimeter          = 10 * Millimeter
-	Meter               = 1e3 * Millimeter
-	Kilometer           = 1e3 * Meter
-	Inch                = 2.54 * Centimeter
-	Feet                = 304.8 * Millimeter
-	Yard                = 3 * Feet
-	Mile                = 5280 * Feet
-	Lightyear           = 9.461e12 * Kilometer
+	Nanometer        Distance = 1
+	Picometer                 = 1e-3 * Nanometer
+	Angstrom                  = 100 * Picometer
+	Micrometer                = 1e3 * Nanometer
+	Millimeter                = 1e3 * Micrometer
+	Centimeter                = 10 * Millimeter
+	Meter                     = 1e3 * Millimeter
+	Kilometer                 = 1e3 * Meter
+	Inch                      = 2.54 * Centimeter
+	Feet                      = 304.8 * Millimeter
+	Yard                      = 3 * Feet
+	Mile                      = 5280 * Feet
+	NauticalMile              = 1852 * Meter
+	Lightyear                 = 9.461e12 * Kilometer
+	AstronomicalUnit          = 149597870700 * Meter
+	Parsec                    = 648000 / math.Pi * AstronomicalUnit
+)
+
+// A System is a system of units used to print distances.
+type System int
+
+// Supported unit systems.
+const (
+	Metric System = iota
+	Imperial
 )
 
 var usingMetric = true
 
+// currentSystem returns the unit system selected by
+// ToggleUnits, UseMetric and UseImperial.
+func currentSystem() System {
+	if usingMetric {
+		return Metric
+	}
+	return Imperial
+}
+
 // ToggleUnits toggles the units (metric <=> imperial) that are printed whenever the String
 // function is called (as is the case in family of printing functions in the fmt package).
 // By default the metric system is used.
@@ -56,137 +84,602 @@ func UseImperial() {
 	usingMetric = false
 }
 
+// systemPrecision holds, for each unit system, the number of digits
+// after the decimal point used by String. It is atomic so that
+// SetSystemPrecision may be called while other goroutines format.
+var systemPrecision [Imperial + 1]atomic.Int32
+
+func init() {
+	SetSystemPrecision(Metric, defaultPrecision)
+	SetSystemPrecision(Imperial, defaultPrecision)
+}
+
+// precision returns the number of digits set by SetSystemPrecision for s.
+func (s System) precision() int {
+	return int(systemPrecision[s].Load())
+}
+
+// SetSystemPrecision sets the number of digits after the decimal point
+// that String prints when the system s is in use, so that, for example,
+// imperial distances can be printed with more digits than metric ones.
+// A negative prec prints the smallest number of digits necessary
+// to represent the value exactly (see strconv.FormatFloat).
+// By default both systems use six digits. It is safe to call
+// SetSystemPrecision concurrently with String.
+func SetSystemPrecision(s System, prec int) {
+	systemPrecision[s].Store(int32(prec))
+}
+
 // String returns a string representing the distance in the form "10m" or "10yd".
-// The unit that is used is based on the state of the ToggleUnit function.
+// The unit that is used is based on the state of the ToggleUnit function,
+// and the number of digits on SetSystemPrecision.
 // As a special case, distances less than one
 // meter (or yard) use a smaller unit to ensure
 // that the leading digit is non-zero. The zero duration formats as 0m or 0yd.
+// Distances of one kilometer (or mile) or more are printed in
+// kilometers (or miles), up to the astronomical units and parsecs
+// of the metric system.
 func (d Distance) String() string {
-	// If in metric mode
-	if usingMetric {
-		return d.printMetric()
-	}
-	return d.printImperial()
+	return d.format(currentSystem())
+}
+
+// Nanometers returns the distance as a floating point number of nanometers.
+func (d Distance) Nanometers() float64 {
+	return float64(d / Nanometer)
+}
+
+// Micrometers returns the distance as a floating point number of micrometers.
+func (d Distance) Micrometers() float64 {
+	return float64(d / Micrometer)
+}
+
+// Millimeters returns the distance as a floating point number of millimeters.
+func (d Distance) Millimeters() float64 {
+	return float64(d / Millimeter)
+}
+
+// Centimeters returns the distance as a floating point number of centimeters.
+func (d Distance) Centimeters() float64 {
+	return float64(d / Centimeter)
+}
+
+// Meters returns the distance as a floating point number of meters.
+func (d Distance) Meters() float64 {
+	return float64(d / Meter)
+}
+
+// Kilometers returns the distance as a floating point number of kilometers.
+func (d Distance) Kilometers() float64 {
+	return float64(d / Kilometer)
+}
+
+// Inches returns the distance as a floating point number of inches.
+func (d Distance) Inches() float64 {
+	return float64(d / Inch)
+}
+
+// Feet returns the distance as a floating point number of feet.
+func (d Distance) Feet() float64 {
+	return float64(d / Feet)
+}
+
+// Yards returns the distance as a floating point number of yards.
+func (d Distance) Yards() float64 {
+	return float64(d / Yard)
+}
+
+// Miles returns the distance as a floating point number of miles.
+func (d Distance) Miles() float64 {
+	return float64(d / Mile)
+}
+
+// Lightyears returns the distance as a floating point number of light years.
+func (d Distance) Lightyears() float64 {
+	return float64(d / Lightyear)
+}
+
+// FromNanometers returns the distance of f nanometers.
+func FromNanometers(f float64) Distance {
+	return Distance(f) * Nanometer
+}
+
+// FromMicrometers returns the distance of f micrometers.
+func FromMicrometers(f float64) Distance {
+	return Distance(f) * Micrometer
+}
+
+// FromMillimeters returns the distance of f millimeters.
+func FromMillimeters(f float64) Distance {
+	return Distance(f) * Millimeter
+}
+
+// FromCentimeters returns the distance of f centimeters.
+func FromCentimeters(f float64) Distance {
+	return Distance(f) * Centimeter
+}
+
+// FromMeters returns the distance of f meters.
+func FromMeters(f float64) Distance {
+	return Distance(f) * Meter
+}
+
+// FromKilometers returns the distance of f kilometers.
+func FromKilometers(f float64) Distance {
+	return Distance(f) * Kilometer
+}
+
+// FromInches returns the distance of f inches.
+func FromInches(f float64) Distance {
+	return Distance(f) * Inch
+}
+
+// FromFeet returns the distance of f feet.
+func FromFeet(f float64) Distance {
+	return Distance(f) * Feet
 }
 
-func (d Distance) printMetric() string {
-	if d >= 1*Meter {
-		return fmt.Sprintf("%fm", float64(d)/float64(Meter))
+// FromYards returns the distance of f yards.
+func FromYards(f float64) Distance {
+	return Distance(f) * Yard
+}
+
+// FromMiles returns the distance of f miles.
+func FromMiles(f float64) Distance {
+	return Distance(f) * Mile
+}
+
+// FromLightyears returns the distance of f light years.
+func FromLightyears(f float64) Distance {
+	return Distance(f) * Lightyear
+}
+
+// format returns a string representing the distance
+// in the units and precision of the system s.
+func (d Distance) format(s System) string {
+	return string(AppendDistance(make([]byte, 0, 24), d, s, s.precision()))
+}
+
+// formatIn returns a string representing the distance as a number
+// of the unit u with prec digits after the decimal point.
+func (d Distance) formatIn(u namedUnit, prec int) string {
+	return strconv.FormatFloat(float64(d)/float64(u.unit), 'f', prec, 64) + u.symbol
+}
+
+// A namedUnit is a unit along with the suffix used to print it.
+type namedUnit struct {
+	unit   Distance
+	symbol string
+}
+
+// ladders holds, for each unit system, the units used to print
+// distances ordered from largest to smallest.
+var ladders = [...][]namedUnit{
+	Metric: {
+		{Parsec, "pc"},
+		{AstronomicalUnit, "au"},
+		{Kilometer, "km"},
+		{Meter, "m"},
+		{Centimeter, "cm"},
+		{Millimeter, "mm"},
+		{Micrometer, "µm"},
+		{Nanometer, "nm"},
+		{Picometer, "pm"},
+	},
+	Imperial: {
+		{Mile, "mi"},
+		{Yard, "yd"},
+		{Feet, "ft"},
+		{Inch, "in"},
+	},
+}
+
+// NextLargerUnit returns the unit that follows current in the ladder of
+// units used by String for the system selected by ToggleUnits, along with
+// its suffix, such as Meter and "m" for Centimeter in the metric system.
+// It reports false if current is the largest unit of the ladder or is not
+// in it. Together with NextSmallerUnit it lets a user interface step
+// through the units a distance can be displayed in.
+func NextLargerUnit(current Distance) (Distance, string, bool) {
+	ladder := ladders[currentSystem()]
+	for i, u := range ladder {
+		if u.unit == current && i > 0 {
+			return ladder[i-1].unit, ladder[i-1].symbol, true
+		}
 	}
-	if d >= 1*Centimeter {
-		return fmt.Sprintf("%fcm", float64(d)/float64(Centimeter))
+	return 0, "", false
+}
+
+// NextSmallerUnit is like NextLargerUnit, but returns the unit that
+// precedes current, such as Centimeter and "cm" for Meter.
+// It reports false if current is the smallest unit of the ladder
+// or is not in it.
+func NextSmallerUnit(current Distance) (Distance, string, bool) {
+	ladder := ladders[currentSystem()]
+	for i, u := range ladder {
+		if u.unit == current && i+1 < len(ladder) {
+			return ladder[i+1].unit, ladder[i+1].symbol, true
+		}
 	}
-	if d >= 1*Millimeter {
-		return fmt.Sprintf("%fmm", float64(d)/float64(Millimeter))
+	return 0, "", false
+}
+
+// baseUnits holds, for each unit system, the unit used to print
+// the zero distance.
+var baseUnits = [...]namedUnit{
+	Metric:   {Meter, "m"},
+	Imperial: {Yard, "yd"},
+}
+
+// bestUnit returns the largest unit of the system s in which the
+// magnitude of d has a non-zero leading digit, falling back to the
+// smallest unit for tiny distances. The zero distance uses the base unit
+// of the system, meters or yards.
+func bestUnit(d Distance, s System) namedUnit {
+	return switchUnit(d, s, 1)
+}
+
+// switchUnit is like bestUnit, but switches to a unit as soon as
+// the magnitude of d reaches threshold of that unit.
+func switchUnit(d Distance, s System, threshold float64) namedUnit {
+	ladder := ladders[s]
+	if d == 0 {
+		return baseUnits[s]
 	}
-	if d >= 1*Micrometer {
-		return fmt.Sprintf("%fµm", float64(d)/float64(Micrometer))
+	if d < 0 {
+		d = -d
 	}
-	if d == 0 {
-		return fmt.Sprintf("0m")
+	for _, u := range ladder {
+		if d >= Distance(threshold)*u.unit {
+			return u
+		}
 	}
-	return fmt.Sprintf("%fnm", float64(d)/float64(Nanometer))
+	return ladder[len(ladder)-1]
 }
 
-func (d Distance) printImperial() string {
-	if d >= 1*Yard {
-		return fmt.Sprintf("%fyd", float64(d)/float64(Yard))
+var unitMap = map[string]float64{
+	"pm":  float64(Picometer),
+	"A":   float64(Angstrom),
+	"Å":   float64(Angstrom), // U+00C5 = Latin capital letter A with ring above
+	"Å":   float64(Angstrom), // U+212B = angstrom sign
+	"nm":  float64(Nanometer),
+	"um":  float64(Micrometer), // U+03BC = Greek letter mu
+	"µm":  float64(Micrometer), // U+00B5 = micro symbol
+	"μm":  float64(Micrometer), // U+03BC = Greek letter mu
+	"mm":  float64(Millimeter),
+	"cm":  float64(Centimeter),
+	"m":   float64(Meter),
+	"km":  float64(Kilometer),
+	"in":  float64(Inch),
+	"ft":  float64(Feet),
+	"yd":  float64(Yard),
+	"mi":  float64(Mile),
+	"nmi": float64(NauticalMile),
+	"ly":  float64(Lightyear),
+	"au":  float64(AstronomicalUnit),
+	"pc":  float64(Parsec),
+}
+
+// Suffix returns the suffix used to print the unit, such as "km" for
+// Kilometer, and reports whether unit is one of the units accepted by
+// ParseDistance. A unit with several spellings, such as Micrometer,
+// is given the one that String prints, or else the shortest.
+func Suffix(unit Distance) (string, bool) {
+	for _, ladder := range ladders {
+		for _, u := range ladder {
+			if u.unit == unit {
+				return u.symbol, true
+			}
+		}
 	}
-	if d >= 1*Feet {
-		return fmt.Sprintf("%fft", float64(d)/float64(Feet))
+	suffix, ok := "", false
+	for sp, u := range unitMap {
+		if Distance(u) != unit {
+			continue
+		}
+		if !ok || len(sp) < len(suffix) || len(sp) == len(suffix) && sp < suffix {
+			suffix, ok = sp, true
+		}
 	}
-	if d == 0 {
-		return fmt.Sprintf("0yd")
+	return suffix, ok
+}
+
+// FormatIn returns a string representing the distance as a number of
+// the given unit with prec digits after the decimal point, followed by
+// the unit's suffix, such as "2.500km" for 2500m in kilometers with three
+// digits. Unlike String, the unit never changes with the magnitude of the
+// distance. A negative prec uses the smallest number of digits necessary
+// to represent the value exactly. If unit has no suffix (see Suffix),
+// the distance is printed in nanometers instead.
+func (d Distance) FormatIn(unit Distance, prec int) string {
+	suffix, ok := Suffix(unit)
+	if !ok {
+		unit, suffix = Nanometer, "nm"
 	}
-	return fmt.Sprintf("%fin", float64(d)/float64(Inch))
+	return d.formatIn(namedUnit{unit, suffix}, prec)
 }
 
-var unitMap = map[string]float64{
-	"nm": float64(Nanometer),
-	"um": float64(Micrometer), // U+03BC = Greek letter mu
-	"µm": float64(Micrometer), // U+00B5 = micro symbol
-	"μm": float64(Micrometer), // U+03BC = Greek letter mu
-	"mm": float64(Millimeter),
-	"cm": float64(Centimeter),
-	"m":  float64(Meter),
-	"km": float64(Kilometer),
-	"in": float64(Inch),
-	"ft": float64(Feet),
-	"yd": float64(Yard),
-	"mi": float64(Mile),
-	"ly": float64(Lightyear),
+// Spellings returns, in sorted order, every unit suffix accepted by
+// ParseDistance for the same unit as the suffix canonical, including
+// canonical itself. For example, Spellings("µm") returns "um", "µm" and "μm".
+// It returns nil if canonical is not a valid unit suffix.
+func Spellings(canonical string) []string {
+	unit, ok := unitMap[canonical]
+	if !ok {
+		return nil
+	}
+	var spellings []string
+	for u, v := range unitMap {
+		if v == unit {
+			spellings = append(spellings, u)
+		}
+	}
+	sort.Strings(spellings)
+	return spellings
 }
 
 // This code was heavily inspired by the functions
 // provided in https://golang.org/src/time/format.go .
 // Many thanks to them for making this easier on myself.
 
-var errLeadingInt = errors.New("time: bad [0-9]*") // never printed
-
-// leadingInt consumes the leading [0-9]* from s.
-func leadingInt(s string) (x float64, rem string, err error) {
+// leadingDigits consumes the leading [0-9]* from s.
+// If underscores is set, single underscores between digits are skipped.
+// The digits are not converted here: the whole number is handed to
+// strconv.ParseFloat once its extent is known, so there is no limit
+// on how many digits it may have.
+func leadingDigits(s string, underscores bool) (rem string) {
 	i := 0
 	for ; i < len(s); i++ {
-		c := s[i]
-		if c < '0' || c > '9' {
-			break
-		}
-		if x > (1<<63-1)/10 {
-			// overflow
-			return 0, "", errLeadingInt
+		if underscores && isDigitSeparator(s, i) {
+			continue
 		}
-		x = x*10 + float64(int64(c)-'0')
-		if x < 0 {
-			// overflow
-			return 0, "", errLeadingInt
+		if !isDigit(s[i]) {
+			break
 		}
 	}
-	return x, s[i:], nil
+	return s[i:]
 }
 
-// leadingFraction consumes the leading [0-9]* from s.
-// It is used only for fractions, so does not return an error on overflow,
-// it just stops accumulating precision.
-func leadingFraction(s string) (x int64, scale float64, rem string) {
-	i := 0
-	scale = 1
-	overflow := false
-	for ; i < len(s); i++ {
+// leadingExponent consumes a leading exponent [eE][-+]?[0-9]+ from s.
+// If s does not start with an exponent, it is returned unchanged,
+// so that a unit starting with "e" is left for the caller.
+func leadingExponent(s string) (rem string) {
+	if s == "" || (s[0] != 'e' && s[0] != 'E') {
+		return s
+	}
+	i := 1
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+	if i == len(s) || !isDigit(s[i]) {
+		return s
+	}
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[i:]
+}
+
+// isDigitSeparator reports whether s[i] is an underscore
+// between two digits.
+func isDigitSeparator(s string, i int) bool {
+	return s[i] == '_' && i > 0 && isDigit(s[i-1]) && i+1 < len(s) && isDigit(s[i+1])
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// Errors returned by ParseDistance and Parser.Parse, wrapped in a
+// ParseError. Use errors.Is to tell them apart.
+var (
+	// ErrInvalidDistance reports a malformed distance string,
+	// such as "" or "1..5m".
+	ErrInvalidDistance = errors.New("length: invalid distance")
+	// ErrUnknownUnit reports a unit suffix that is not a valid
+	// distance unit, such as the "furlong" of "1furlong".
+	ErrUnknownUnit = errors.New("length: unknown unit")
+	// ErrMissingUnit reports a number without a unit suffix, such as "12".
+	ErrMissingUnit = errors.New("length: missing unit")
+	// ErrOverflow reports a distance too long to be represented,
+	// such as "1e300pc".
+	ErrOverflow = errors.New("length: distance out of range")
+)
+
+// A ParseError describes a distance string, or an area or velocity
+// string, that could not be parsed.
+type ParseError struct {
+	Input string // the string being parsed
+	Part  string // the offending part of Input, if known
+	Err   error  // ErrInvalidDistance, ErrUnknownUnit, ErrMissingUnit or ErrOverflow
+
+	reason string // further detail for ErrInvalidDistance
+	noun   string // what Input is, if not a distance, such as "area"
+}
+
+// Error returns a description of the error, such as
+// "length: unknown unit furlong in distance 1furlong".
+func (e *ParseError) Error() string {
+	noun := e.noun
+	if noun == "" {
+		noun = "distance"
+	}
+	switch e.Err {
+	case ErrUnknownUnit:
+		return "length: unknown unit " + e.Part + " in " + noun + " " + e.Input
+	case ErrMissingUnit:
+		return "length: missing unit in " + noun + " " + e.Input
+	case ErrOverflow:
+		return "length: " + noun + " " + e.Input + " out of range"
+	}
+	if e.reason != "" {
+		return "length: " + e.reason + " in " + noun + " " + e.Input
+	}
+	return "length: invalid " + noun + " " + e.Input
+}
+
+// Unwrap returns the underlying error, so that errors.Is(err,
+// ErrUnknownUnit) reports whether a ParseError is for an unknown unit.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseDistance parses a distance string.
+// A distance string is a possibly signed sequence of
+// decimal numbers, each with optional fraction, optional exponent
+// and a unit suffix, such as "300m", "-1.5ly" or "1.5e6nm".
+// Blanks may separate a number from its unit and one unit from the
+// next number, as in "5 ft 11 in", and are ignored at either end.
+// Valid distance units are "pm", "A" (or "Å"), "nm", "um" (or "µm"), "mm", "m", "km", "in", "ft",
+// "yd", "mi", "nmi", "ly", "au", "pc".
+//
+// A unit suffix is every character up to the next digit, period or blank,
+// and must match a unit exactly; it is never split into shorter units.
+// So "1nmi" is one nautical mile rather than a nanometer followed by
+// a stray "i", "1mmi" is an unknown unit, and "2m3mm" is two units.
+// An "e" or "E" begins an exponent only when digits follow it.
+func ParseDistance(s string) (Distance, error) {
+	return Parser{}.Parse(s)
+}
+
+// MustParseDistance is like ParseDistance but panics if the string
+// cannot be parsed. It simplifies safe initialization of global
+// variables holding distances.
+func MustParseDistance(s string) Distance {
+	d, err := ParseDistance(s)
+	if err != nil {
+		panic(`length: ParseDistance(` + strconv.Quote(s) + `): ` + err.Error())
+	}
+	return d
+}
+
+// ParseDistanceGrouped is like ParseDistance, but also accepts numbers
+// whose digits are grouped with underscores or commas, as pasted from
+// spreadsheets, such as "1,000m", "5,280.5ft" or "1_000_000nm".
+// An underscore may appear between any two digits. A comma may only
+// appear in the integer part of a number and must split it into groups
+// of three digits after the first, so a decimal comma as in "1,5m" is
+// an error rather than 15 meters.
+func ParseDistanceGrouped(s string) (Distance, error) {
+	stripped, ok := stripDigitGroups(s)
+	if !ok {
+		return 0, &ParseError{Input: s, Err: ErrInvalidDistance, reason: "misplaced digit group separator"}
+	}
+	d, err := ParseDistance(stripped)
+	var perr *ParseError
+	if errors.As(err, &perr) {
+		perr.Input = s
+	}
+	return d, err
+}
+
+// stripDigitGroups removes the digit group separators accepted by
+// ParseDistanceGrouped from s. It reports false if a comma is
+// misplaced. Underscores that are not between digits are left for
+// ParseDistance to reject.
+func stripDigitGroups(s string) (string, bool) {
+	var b strings.Builder
+	run := 0            // digits since the last comma or start of the number
+	grouped := false    // whether the number has had a comma
+	inFraction := false // whether commas are no longer allowed in the number
+	for i := 0; i < len(s); i++ {
 		c := s[i]
-		if c < '0' || c > '9' {
-			break
-		}
-		if overflow {
-			continue
-		}
-		if x > (1<<63-1)/10 {
-			// It's possible for overflow to give a positive number, so take care.
-			overflow = true
+		switch {
+		case isDigit(c):
+			run++
+		case isDigitSeparator(s, i):
 			continue
-		}
-		y := x*10 + int64(c) - '0'
-		if y < 0 {
-			overflow = true
+		case c == ',':
+			if inFraction || run == 0 || run > 3 || grouped && run != 3 {
+				return "", false
+			}
+			grouped, run = true, 0
 			continue
+		default:
+			if grouped && run != 3 {
+				return "", false
+			}
+			grouped, run = false, 0
+			inFraction = c == '.' || c == 'e' || c == 'E' || inFraction && (c == '+' || c == '-')
 		}
-		x = y
-		scale *= 10
+		b.WriteByte(c)
 	}
-	return x, scale, s[i:]
+	return b.String(), !grouped || run == 3
 }
 
-// ParseDistance parses a distance string.
-// A distance string is a possibly signed sequence of
-// decimal numbers, each with optional fraction and a unit suffix,
-// such as "300m" or "-1.5ly"
-// Valid distance units are "nm", "um" (or "µm"), "mm", "m", "km", "in", "ft", "yd", "mi", "ly".
-func ParseDistance(s string) (Distance, error) {
+// ParseDistanceFloat parses a distance string with ParseDistance and
+// returns it as a number of the given unit, so that
+// ParseDistanceFloat("2km", Meter) returns 2000.
+// It returns an error if s is invalid or unit is zero.
+func ParseDistanceFloat(s string, unit Distance) (float64, error) {
+	if unit == 0 {
+		return 0, errors.New("length: zero unit for distance " + s)
+	}
+	d, err := ParseDistance(s)
+	if err != nil {
+		return 0, err
+	}
+	return d.In(unit), nil
+}
+
+// A Parser parses distance strings, optionally relaxing the rules
+// used by ParseDistance so that messy input can still be ingested.
+// The zero Parser behaves exactly like ParseDistance.
+type Parser struct {
+	// OnUnknownUnit, if non-nil, is called with any unit suffix
+	// that is not a valid distance unit. If it returns ok, the suffix
+	// is accepted as a unit of the given number of meters; otherwise
+	// parsing fails as usual.
+	OnUnknownUnit func(suffix string) (meters float64, ok bool)
+
+	// Lenient relaxes the syntax accepted by ParseDistance:
+	// underscores may be used to separate digits, as in "1_000m";
+	// a distance that starts with a unit rather than a number,
+	// such as "km" or "-ft", has an implied count of one of that unit;
+	// and the unit may be written before the number and separated from
+	// it by blanks, as in "km 10". The sign of such a distance may come
+	// before the unit or before the number, as in "-km 10" or "km -10",
+	// but not both. A distance with a unit on both sides of its number,
+	// such as "km 10m", is invalid.
+	Lenient bool
+
+	// EmptyAsZero makes an empty or blank distance string, such as ""
+	// or "   ", parse as zero. By default such a string is invalid,
+	// as it is for ParseDistance.
+	EmptyAsZero bool
+}
+
+// Parse parses a distance string using the rules of ParseDistance
+// as modified by the fields of p.
+func (p Parser) Parse(s string) (Distance, error) {
 
 	// [-+]?([0-9]*(\.[0-9]*)?[a-z]+)+
 	orig := s
 	var d float64
 	neg := false
 
+	if p.EmptyAsZero && strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
+	s = strings.TrimSpace(s)
+
+	// In lenient mode the unit may be written before the number, as in
+	// "km 10", with the sign either before the unit or before the number.
+	if p.Lenient {
+		sign, rest := "", s
+		if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+			sign, rest = rest[:1], rest[1:]
+		}
+		if unit, num, ok := cutLeadingUnit(rest); ok {
+			if strings.IndexFunc(num, isUnitRune) >= 0 {
+				return 0, &ParseError{Input: orig, Part: unit, Err: ErrInvalidDistance, reason: "unit both before and after number"}
+			}
+			if sign != "" && (num[0] == '-' || num[0] == '+') {
+				return 0, &ParseError{Input: orig, Part: num, Err: ErrInvalidDistance, reason: "sign both before unit and number"}
+			}
+			s = sign + num + unit
+		}
+	}
+
 	// Consume [-+]?
 	if s != "" {
 		c := s[0]
@@ -200,27 +693,22 @@ func ParseDistance(s string) (Distance, error) {
 		return 0, nil
 	}
 	if s == "" {
-		return 0, errors.New("length: invalid distance " + orig)
+		return 0, &ParseError{Input: orig, Err: ErrInvalidDistance}
+	}
+	// In lenient mode a lone unit counts as one of that unit.
+	lone := false
+	if p.Lenient && !(s[0] == '.' || s[0] == '_' || isDigit(s[0])) {
+		s, lone = "1"+s, true
 	}
 	for s != "" {
-		var (
-			v     float64
-			f     int64       // integers before, after decimal point
-			scale float64 = 1 // value = v + f/scale
-		)
-
-		var err error
-
 		// The next character must be [0-9.]
 		if !(s[0] == '.' || '0' <= s[0] && s[0] <= '9') {
-			return 0, errors.New("length: invalid distance " + orig)
+			return 0, &ParseError{Input: orig, Part: s, Err: ErrInvalidDistance}
 		}
 		// Consume [0-9]*
+		num := s
 		pl := len(s)
-		v, s, err = leadingInt(s)
-		if err != nil {
-			return 0, errors.New("length: invalid distance " + orig)
-		}
+		s = leadingDigits(s, p.Lenient)
 		pre := pl != len(s) // whether we consumed anything before a period
 
 		// Consume (\.[0-9]*)?
@@ -228,47 +716,66 @@ func ParseDistance(s string) (Distance, error) {
 		if s != "" && s[0] == '.' {
 			s = s[1:]
 			pl := len(s)
-			f, scale, s = leadingFraction(s)
+			s = leadingDigits(s, p.Lenient)
 			post = pl != len(s)
 		}
 		if !pre && !post {
 			// no digits (e.g. ".s" or "-.s")
-			return 0, errors.New("length: invalid distance " + orig)
+			return 0, &ParseError{Input: orig, Part: num[:len(num)-len(s)], Err: ErrInvalidDistance}
+		}
+
+		// Consume ([eE][-+]?[0-9]+)?, except after the "1" implied by a
+		// lone unit, where an e belongs to the unit: "e5m" is not 1e5m.
+		if !lone {
+			s = leadingExponent(s)
+		}
+		lone = false
+		num = num[:len(num)-len(s)]
+
+		// Consume blanks between the number and its unit.
+		s = strings.TrimLeft(s, asciiSpace)
+
+		// The number is converted as a whole, so that it is
+		// correctly rounded no matter how many digits it has.
+		if p.Lenient {
+			num = strings.ReplaceAll(num, "_", "")
+		}
+		v, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) && v != 0 {
+				return 0, &ParseError{Input: orig, Part: num, Err: ErrOverflow}
+			}
+			return 0, &ParseError{Input: orig, Part: num, Err: ErrInvalidDistance}
 		}
 
 		// Consume unit.
 		i := 0
 		for ; i < len(s); i++ {
 			c := s[i]
-			if c == '.' || '0' <= c && c <= '9' {
+			if c == '.' || '0' <= c && c <= '9' || strings.IndexByte(asciiSpace, c) >= 0 {
 				break
 			}
 		}
 		if i == 0 {
-			return 0, errors.New("length: missing unit in distance " + orig)
+			if s != "" && s[0] == '.' {
+				// A second decimal point, as in "1..5m".
+				return 0, &ParseError{Input: orig, Part: s, Err: ErrInvalidDistance}
+			}
+			return 0, &ParseError{Input: orig, Part: num, Err: ErrMissingUnit}
 		}
 		u := s[:i]
-		s = s[i:]
-		unit, ok := unitMap[u]
+		// Consume blanks before the next number, as in "5 ft 11 in".
+		s = strings.TrimLeft(s[i:], asciiSpace)
+		unit, ok := p.unit(u)
 		if !ok {
-			return 0, errors.New("length: unknown unit " + u + " in distance " + orig)
-		}
-		if v > (1<<63-1)/unit {
-			// overflow
-			return 0, errors.New("length: invalid distance " + orig)
+			return 0, &ParseError{Input: orig, Part: u, Err: ErrUnknownUnit}
 		}
+		// A Distance is a float64, so the only overflow is past
+		// math.MaxFloat64 nanometers, which gives an infinity.
 		v *= unit
-		if f > 0 {
-			v += float64(f) * (float64(unit) / scale)
-			if v < 0 {
-				// overflow
-				return 0, errors.New("length: invalid distance " + orig)
-			}
-		}
 		d += v
-		if d < 0 {
-			// overflow
-			return 0, errors.New("length: invalid distance " + orig)
+		if math.IsInf(d, 0) {
+			return 0, &ParseError{Input: orig, Err: ErrOverflow}
 		}
 	}
 
@@ -277,3 +784,132 @@ func ParseDistance(s string) (Distance, error) {
 	}
 	return Distance(d), nil
 }
+
+// isUnitRune reports whether r can only be part of a unit, and not of
+// the number before it.
+func isUnitRune(r rune) bool {
+	return !(r < utf8.RuneSelf && isDigit(byte(r)) || strings.ContainsRune("+-._eE"+asciiSpace, r))
+}
+
+// asciiSpace holds the blanks that ParseDistance allows around units.
+const asciiSpace = " \t\n\v\f\r"
+
+// cutLeadingUnit splits s into a leading unit word and the number that
+// follows it after some blanks, as in "km 10". It reports whether s has
+// that form.
+func cutLeadingUnit(s string) (unit, num string, ok bool) {
+	i := strings.IndexAny(s, " \t")
+	if i <= 0 || strings.ContainsAny(s[:1], "+-._0123456789") {
+		return "", "", false
+	}
+	unit, num = s[:i], strings.TrimLeft(s[i:], " \t")
+	if num == "" || strings.ContainsAny(unit, ".0123456789") {
+		return "", "", false
+	}
+	return unit, num, true
+}
+
+// unit returns the size in nanometers of the unit with suffix u.
+func (p Parser) unit(u string) (float64, bool) {
+	if unit, ok := unitMap[u]; ok {
+		return unit, true
+	}
+	if p.OnUnknownUnit == nil {
+		return 0, false
+	}
+	meters, ok := p.OnUnknownUnit(u)
+	if !ok {
+		return 0, false
+	}
+	return meters * float64(Meter), true
+}
+
+// ParseEngineering parses a number written in engineering notation,
+// such as "1.5E3" or "250e-6", optionally followed by a single SI prefix
+// symbol, and returns that many of the base unit.
+// For example, ParseEngineering("1.5k", Meter) returns 1.5km.
+//
+// The accepted prefixes are the powers of one thousand from "q" (quecto, 1e-30)
+// to "Q" (quetta, 1e30): "q", "r", "y", "z", "a", "f", "p", "n", "µ" (or "u"),
+// "m", "k", "M", "G", "T", "P", "E", "Z", "Y", "R" and "Q".
+// Prefixes are case sensitive, so "250m" is 250 milli and not 250 mega
+// (nor 250 meters) of the base unit, and a trailing "E" is exa
+// rather than an incomplete exponent.
+func ParseEngineering(s string, base Distance) (Distance, error) {
+	num, exp := s, 0
+	if r, size := utf8.DecodeLastRuneInString(s); r != utf8.RuneError {
+		if e, ok := siPrefixExp(string(r)); ok {
+			num, exp = s[:len(s)-size], e
+		}
+	}
+	if num == "" || strings.Trim(num, "0123456789.eE+-") != "" {
+		return 0, errors.New("length: invalid engineering value " + s)
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, errors.New("length: invalid engineering value " + s)
+	}
+	if exp < 0 {
+		v /= math.Pow10(-exp)
+	} else {
+		v *= math.Pow10(exp)
+	}
+	return Distance(v * float64(base)), nil
+}
+
+// siPrefixExp returns the power of ten of the SI prefix symbol p.
+func siPrefixExp(p string) (int, bool) {
+	switch p {
+	case "u", "μ": // U+03BC = Greek letter mu
+		p = "µ"
+	case "":
+		return 0, false
+	}
+	for _, prefix := range siPrefixes {
+		if prefix.symbol == p {
+			return prefix.exp, true
+		}
+	}
+	return 0, false
+}
+
+// ParseSexagesimal parses a distance written like an angle or a time in
+// degrees (or hours) and minutes, where a number following the distance
+// after a blank is a count of sixtieths of its unit. For example,
+// "5m 30" is five and thirty sixtieths of a meter, that is 5.5m.
+// The unit may be omitted, as in "5 30", in which case base is used.
+// The minutes must lie in [0, 60) and may be omitted.
+// This convention is unusual and is only found in some legacy formats,
+// which is why ParseDistance does not accept it.
+func ParseSexagesimal(s string, base Distance) (Distance, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, errors.New("length: invalid sexagesimal distance " + s)
+	}
+	num, u := fields[0], ""
+	if i := strings.IndexFunc(num, func(r rune) bool { return !strings.ContainsRune("+-.0123456789", r) }); i >= 0 {
+		num, u = num[:i], num[i:]
+	}
+	unit := float64(base)
+	if u != "" {
+		var ok bool
+		if unit, ok = unitMap[u]; !ok {
+			return 0, errors.New("length: unknown unit " + u + " in distance " + s)
+		}
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, errors.New("length: invalid sexagesimal distance " + s)
+	}
+	if len(fields) == 2 {
+		m, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || strings.Trim(fields[1], ".0123456789") != "" || m >= 60 {
+			return 0, errors.New("length: invalid minutes in sexagesimal distance " + s)
+		}
+		if strings.HasPrefix(num, "-") {
+			m = -m
+		}
+		v += m / 60
+	}
+	return Distance(v * unit), nil
+}
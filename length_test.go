@@ -1,6 +1,10 @@
 package length
 
 import (
+	"errors"
+	"math"
+	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -18,6 +22,24 @@ func TestDistance_String(t *testing.T) {
 			want:   "0m",
 			before: func() { UseMetric() },
 		},
+		{
+			name:   "Metric - 500 Picometers",
+			d:      Distance(500 * Picometer),
+			want:   "500.000000pm",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - 1.54 Angstroms",
+			d:      Distance(1.54 * Angstrom),
+			want:   "154.000000pm",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - Rounds Up To Kilometers",
+			d:      Distance(999.9999999 * Meter),
+			want:   "1.000000km",
+			before: func() { UseMetric() },
+		},
 		{
 			name:   "Metric - 2 Nanometers",
 			d:      Distance(2 * Nanometer),
@@ -48,16 +70,64 @@ func TestDistance_String(t *testing.T) {
 			want:   "2.000000m",
 			before: func() { UseMetric() },
 		},
+		{
+			name:   "Metric - Negative 2 Meters",
+			d:      Distance(-2 * Meter),
+			want:   "-2.000000m",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - 999 Meters",
+			d:      Distance(999 * Meter),
+			want:   "999.000000m",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - 1 Kilometer",
+			d:      Distance(1000 * Meter),
+			want:   "1.000000km",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - 1500 Kilometers",
+			d:      Distance(1500 * Kilometer),
+			want:   "1500.000000km",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - 950 Micrometers",
+			d:      Distance(950 * Micrometer),
+			want:   "950.000000µm",
+			before: func() { UseMetric() },
+		},
 		{
 			name:   "Metric - 2 Kilometers",
 			d:      Distance(2 * Kilometer),
-			want:   "2000.000000m",
+			want:   "2.000000km",
 			before: func() { UseMetric() },
 		},
 		{
 			name:   "Metric - 2 Lightyears",
 			d:      Distance(2 * Lightyear),
-			want:   "18922000000000000.000000m",
+			want:   "126485.757528au",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - Earth To Sun",
+			d:      Distance(AstronomicalUnit),
+			want:   "1.000000au",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - Alpha Centauri",
+			d:      Distance(1.34 * Parsec),
+			want:   "1.340000pc",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - Below An Astronomical Unit",
+			d:      Distance(384400 * Kilometer),
+			want:   "384400.000000km",
 			before: func() { UseMetric() },
 		},
 		{
@@ -87,6 +157,36 @@ func TestDistance_String(t *testing.T) {
 			want:   "2.000000yd",
 			before: func() { UseImperial() },
 		},
+		{
+			name:   "Imperial - Just Below A Mile",
+			d:      Distance(1759 * Yard),
+			want:   "1759.000000yd",
+			before: func() { UseImperial() },
+		},
+		{
+			name:   "Imperial - 1 mi",
+			d:      Distance(1760 * Yard),
+			want:   "1.000000mi",
+			before: func() { UseImperial() },
+		},
+		{
+			name:   "Imperial - 2 mi",
+			d:      Distance(2 * Mile),
+			want:   "2.000000mi",
+			before: func() { UseImperial() },
+		},
+		{
+			name:   "Imperial - Negative 26.2 mi",
+			d:      Distance(-26.2 * Mile),
+			want:   "-26.200000mi",
+			before: func() { UseImperial() },
+		},
+		{
+			name:   "Imperial - 2 Lightyears",
+			d:      Distance(2 * Lightyear),
+			want:   "11757585699514.833984mi",
+			before: func() { UseImperial() },
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -98,6 +198,69 @@ func TestDistance_String(t *testing.T) {
 	}
 }
 
+func TestDistance_Accessors(t *testing.T) {
+	d := 5 * Mile
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "Nanometers", got: d.Nanometers(), want: 8046720000000},
+		{name: "Micrometers", got: d.Micrometers(), want: 8046720000},
+		{name: "Millimeters", got: d.Millimeters(), want: 8046720},
+		{name: "Centimeters", got: d.Centimeters(), want: 804672},
+		{name: "Meters", got: d.Meters(), want: 8046.72},
+		{name: "Kilometers", got: d.Kilometers(), want: 8.04672},
+		{name: "Inches", got: d.Inches(), want: 316800},
+		{name: "Feet", got: d.Feet(), want: 26400},
+		{name: "Yards", got: d.Yards(), want: 8800},
+		{name: "Miles", got: d.Miles(), want: 5},
+		{name: "Lightyears", got: (2.5 * Lightyear).Lightyears(), want: 2.5},
+		{name: "Negative Meters", got: (-3 * Kilometer).Meters(), want: -3000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-12*math.Abs(tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromUnits(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "Nanometers", got: FromNanometers(2).Nanometers(), want: 2},
+		{name: "Micrometers", got: FromMicrometers(2).Micrometers(), want: 2},
+		{name: "Millimeters", got: FromMillimeters(2).Millimeters(), want: 2},
+		{name: "Centimeters", got: FromCentimeters(2).Centimeters(), want: 2},
+		{name: "Meters", got: FromMeters(2).Meters(), want: 2},
+		{name: "Kilometers", got: FromKilometers(2).Kilometers(), want: 2},
+		{name: "Inches", got: FromInches(2).Inches(), want: 2},
+		{name: "Feet", got: FromFeet(2).Feet(), want: 2},
+		{name: "Yards", got: FromYards(2).Yards(), want: 2},
+		{name: "Miles", got: FromMiles(3.1).Miles(), want: 3.1},
+		{name: "Lightyears", got: FromLightyears(2).Lightyears(), want: 2},
+		{name: "Feet In Meters", got: FromFeet(1).Meters(), want: 0.3048},
+		{name: "Miles In Kilometers", got: FromMiles(3.1).Kilometers(), want: 3.1 * 1.609344},
+		{name: "Inches In Centimeters", got: FromInches(-12).Centimeters(), want: -30.48},
+		{name: "Yards In Feet", got: FromYards(0.5).Feet(), want: 1.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-12*math.Abs(tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+	if got := FromMeters(2).Meters(); got != 2 {
+		t.Errorf("FromMeters(2).Meters() = %v, want 2", got)
+	}
+}
+
 func TestParseDistance(t *testing.T) {
 	type args struct {
 		s string
@@ -133,55 +296,1484 @@ func TestParseDistance(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "No Unit",
+			name: "Nanometer Not Nautical Mile",
 			args: args{
-				s: "12",
+				s: "1nm",
+			},
+			want:    Distance(Nanometer),
+			wantErr: false,
+		},
+		{
+			name: "Nautical Mile",
+			args: args{
+				s: "1nmi",
+			},
+			want:    Distance(NauticalMile),
+			wantErr: false,
+		},
+		{
+			name: "Nautical Miles Then Nanometers",
+			args: args{
+				s: "2nmi5nm",
+			},
+			want:    Distance(2*NauticalMile + 5*Nanometer),
+			wantErr: false,
+		},
+		{
+			name: "Spaced Single Unit",
+			args: args{
+				s: "100.5 yd",
+			},
+			want:    Distance(100.5 * Yard),
+			wantErr: false,
+		},
+		{
+			name: "Spaced Compound Units",
+			args: args{
+				s: "5 ft 11 in",
+			},
+			want:    Distance(5*Feet + 11*Inch),
+			wantErr: false,
+		},
+		{
+			name: "Space Between Groups Only",
+			args: args{
+				s: "5ft 11in",
+			},
+			want:    Distance(5*Feet + 11*Inch),
+			wantErr: false,
+		},
+		{
+			name: "Tab Between Number And Unit",
+			args: args{
+				s: "3\tm",
+			},
+			want:    Distance(3 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Trimmed Tabs And Newlines",
+			args: args{
+				s: "\t\n2.5km\n",
+			},
+			want:    Distance(2500 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Spaced Negative Compound",
+			args: args{
+				s: "-1 m 50 cm",
+			},
+			want:    Distance(-150 * Centimeter),
+			wantErr: false,
+		},
+		{
+			name: "Trimmed Zero",
+			args: args{
+				s: " 0\n",
+			},
+			want:    Distance(0),
+			wantErr: false,
+		},
+		{
+			name: "Space After Sign",
+			args: args{
+				s: "- 5m",
 			},
 			want:    Distance(0),
 			wantErr: true,
 		},
 		{
-			name: "Negative Unit",
+			name: "Space Inside Unit",
 			args: args{
-				s: "-1nm",
+				s: "5 f t",
 			},
-			want:    Distance(-1 * Nanometer),
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Interior Garbage",
+			args: args{
+				s: "5 ft x 11 in",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Space Between Numbers",
+			args: args{
+				s: "5 5m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Spaced Number Without Unit",
+			args: args{
+				s: "5 ",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Angstroms",
+			args: args{
+				s: "25Å",
+			},
+			want:    Distance(2.5 * Nanometer),
 			wantErr: false,
 		},
 		{
-			name: "Partial Unit",
+			name: "Angstrom Sign",
 			args: args{
-				s: "-1.9mi",
+				s: "2Å",
 			},
-			want:    Distance(-1.9 * Mile),
+			want:    Distance(0.2 * Nanometer),
 			wantErr: false,
 		},
 		{
-			name: "No Digits",
+			name: "ASCII Angstroms",
+			args: args{
+				s: "2.5A",
+			},
+			want:    Distance(0.25 * Nanometer),
+			wantErr: false,
+		},
+		{
+			name: "Picometers",
+			args: args{
+				s: "154pm",
+			},
+			want:    Distance(0.154 * Nanometer),
+			wantErr: false,
+		},
+		{
+			name: "Nanometers And Angstroms",
+			args: args{
+				s: "1nm5Å",
+			},
+			want:    Distance(1.5 * Nanometer),
+			wantErr: false,
+		},
+		{
+			name: "Leading Decimal",
+			args: args{
+				s: ".5m",
+			},
+			want:    Distance(0.5 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Negative Leading Decimal",
+			args: args{
+				s: "-.25km",
+			},
+			want:    Distance(-250 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Leading Decimal Without Unit",
+			args: args{
+				s: ".5",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Point Without Digits",
+			args: args{
+				s: ".m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lone Point",
+			args: args{
+				s: ".",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Signed Point Without Digits",
 			args: args{
 				s: "-.ly",
 			},
-			want:    0,
+			want:    Distance(0),
 			wantErr: true,
 		},
 		{
-			name: "Multiple Units",
+			name: "One Lightyear",
 			args: args{
-				s: "5ft11in",
+				s: "1ly",
 			},
-			want:    Distance(5*Feet) + Distance(11*Inch),
+			want:    Distance(Lightyear),
 			wantErr: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := ParseDistance(tt.args.s)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("ParseDistance() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("ParseDistance() = %v, want %v", got, tt.want)
+		{
+			name: "Observable Universe",
+			args: args{
+				s: "93e9ly",
+			},
+			want:    Distance(93e9 * Lightyear),
+			wantErr: false,
+		},
+		{
+			name: "More Digits Than An Int64",
+			args: args{
+				s: "99999999999999999999m",
+			},
+			want:    Distance(1e20 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Largest Representable",
+			args: args{
+				s: "1.9e283ly",
+			},
+			want:    Distance(1.9e283 * Lightyear),
+			wantErr: false,
+		},
+		{
+			name: "Past Largest Representable",
+			args: args{
+				s: "1.91e283ly",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Sum Past Largest Representable",
+			args: args{
+				s: "1.5e283ly1.5e283ly",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Number Past Largest Float",
+			args: args{
+				s: "1e309nm",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Parsecs",
+			args: args{
+				s: "1.3pc",
+			},
+			want:    Distance(1.3 * Parsec),
+			wantErr: false,
+		},
+		{
+			name: "Astronomical Units",
+			args: args{
+				s: "5.2au",
+			},
+			want:    Distance(5.2 * AstronomicalUnit),
+			wantErr: false,
+		},
+		{
+			name: "Exponent",
+			args: args{
+				s: "1.5e6nm",
+			},
+			want:    Distance(1.5 * Millimeter),
+			wantErr: false,
+		},
+		{
+			name: "Negative Exponent",
+			args: args{
+				s: "25E-2m",
+			},
+			want:    Distance(25 * Centimeter),
+			wantErr: false,
+		},
+		{
+			name: "Exponent Without Digits",
+			args: args{
+				s: "1e+m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Exponent Overflow",
+			args: args{
+				s: "1e999nm",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "No Unit",
+			args: args{
+				s: "12",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Negative Unit",
+			args: args{
+				s: "-1nm",
+			},
+			want:    Distance(-1 * Nanometer),
+			wantErr: false,
+		},
+		{
+			name: "Partial Unit",
+			args: args{
+				s: "-1.9mi",
+			},
+			want:    Distance(-1.9 * Mile),
+			wantErr: false,
+		},
+		{
+			name: "No Digits",
+			args: args{
+				s: "-.ly",
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Multiple Units",
+			args: args{
+				s: "5ft11in",
+			},
+			want:    Distance(5*Feet) + Distance(11*Inch),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDistance(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDistance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDistance_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        Parser
+		s        string
+		wantErr  error
+		wantPart string
+		wantText string
+	}{
+		{
+			name:     "Empty",
+			s:        "",
+			wantErr:  ErrInvalidDistance,
+			wantText: "length: invalid distance ",
+		},
+		{
+			name:     "Bad Character",
+			s:        "?1m",
+			wantErr:  ErrInvalidDistance,
+			wantPart: "?1m",
+			wantText: "length: invalid distance ?1m",
+		},
+		{
+			name:     "No Digits",
+			s:        "-.m",
+			wantErr:  ErrInvalidDistance,
+			wantPart: ".",
+			wantText: "length: invalid distance -.m",
+		},
+		{
+			name:     "Two Decimal Points",
+			s:        "1..5m",
+			wantErr:  ErrInvalidDistance,
+			wantPart: ".5m",
+			wantText: "length: invalid distance 1..5m",
+		},
+		{
+			name:     "Unit On Both Sides",
+			p:        Parser{Lenient: true},
+			s:        "km 10m",
+			wantErr:  ErrInvalidDistance,
+			wantPart: "km",
+			wantText: "length: unit both before and after number in distance km 10m",
+		},
+		{
+			name:     "Unit Before And Inside",
+			p:        Parser{Lenient: true},
+			s:        "km 1m 2",
+			wantErr:  ErrInvalidDistance,
+			wantPart: "km",
+			wantText: "length: unit both before and after number in distance km 1m 2",
+		},
+		{
+			name:     "Unknown Unit",
+			s:        "1furlong",
+			wantErr:  ErrUnknownUnit,
+			wantPart: "furlong",
+			wantText: "length: unknown unit furlong in distance 1furlong",
+		},
+		{
+			name:     "Missing Unit",
+			s:        "12",
+			wantErr:  ErrMissingUnit,
+			wantPart: "12",
+			wantText: "length: missing unit in distance 12",
+		},
+		{
+			name:     "Overflowing Number",
+			s:        "1e999nm",
+			wantErr:  ErrOverflow,
+			wantPart: "1e999",
+			wantText: "length: distance 1e999nm out of range",
+		},
+		{
+			name:     "Overflowing Unit",
+			s:        "1e300pc",
+			wantErr:  ErrOverflow,
+			wantText: "length: distance 1e300pc out of range",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.p.Parse(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parser.Parse() error = %v, want errors.Is %v", err, tt.wantErr)
+			}
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parser.Parse() error = %T, want *ParseError", err)
+			}
+			if perr.Input != tt.s || perr.Part != tt.wantPart {
+				t.Errorf("ParseError Input, Part = %q, %q, want %q, %q", perr.Input, perr.Part, tt.s, tt.wantPart)
+			}
+			if err.Error() != tt.wantText {
+				t.Errorf("ParseError.Error() = %q, want %q", err.Error(), tt.wantText)
+			}
+		})
+	}
+}
+
+func TestMustParseDistance(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		want      Distance
+		wantPanic bool
+	}{
+		{
+			name: "Valid",
+			s:    "5ft11in",
+			want: 5*Feet + 11*Inch,
+		},
+		{
+			name: "Zero",
+			s:    "0",
+			want: 0,
+		},
+		{
+			name:      "Unknown Unit",
+			s:         "2furlongs",
+			wantPanic: true,
+		},
+		{
+			name:      "Empty",
+			s:         "",
+			wantPanic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("MustParseDistance() panic = %v, wantPanic %v", r, tt.wantPanic)
+				}
+			}()
+			if got := MustParseDistance(tt.s); got != tt.want {
+				t.Errorf("MustParseDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDistance_Suffixes(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Distance
+		wantErr error
+	}{
+		{name: "Nautical Mile Not Nanometer", s: "1nmi", want: NauticalMile},
+		{name: "Nanometer", s: "1nm", want: Nanometer},
+		{name: "Mile Not Meter", s: "1mi", want: Mile},
+		{name: "Millimeter Not Meter", s: "1mm", want: Millimeter},
+		{name: "Meter Then Millimeter", s: "2m3mm", want: 2*Meter + 3*Millimeter},
+		{name: "Mile Then Meter", s: "1mi1m", want: Mile + Meter},
+		{name: "Exponent Before Unit", s: "1e3m", want: Kilometer},
+		{name: "Not Split Into Known Units", s: "1mmi", wantErr: ErrUnknownUnit},
+		{name: "Not A Prefix Of Longer Unit", s: "1nmm", wantErr: ErrUnknownUnit},
+		{name: "E Without Exponent Digits", s: "1em", wantErr: ErrUnknownUnit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDistance(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseDistance() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Every unit must parse as itself, including one that begins with
+	// or contains the spelling of another.
+	for suffix, unit := range unitMap {
+		if got, err := ParseDistance("1" + suffix); err != nil || got != Distance(unit) {
+			t.Errorf("ParseDistance(%q) = %v, %v, want %v", "1"+suffix, got, err, Distance(unit))
+		}
+		if c := suffix[0]; c == 'e' || c == 'E' {
+			t.Errorf("unit %q could be mistaken for an exponent", suffix)
+		}
+	}
+}
+
+func TestParseDistanceGrouped(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Distance
+		wantErr bool
+	}{
+		{name: "Comma Thousands", s: "1,000m", want: Kilometer},
+		{name: "Comma Millions", s: "1,000,000nm", want: Millimeter},
+		{name: "Comma With Fraction", s: "5,280.5ft", want: 5280.5 * Feet},
+		{name: "Negative Comma", s: "-12,345m", want: -12345 * Meter},
+		{name: "Underscores", s: "1_000_000nm", want: Millimeter},
+		{name: "Underscore In Fraction", s: "0.000_5m", want: 500 * Micrometer},
+		{name: "Compound", s: "5,280ft 1_2in", want: 5280*Feet + 12*Inch},
+		{name: "Comma And Exponent", s: "1,000e3m", want: 1000 * Kilometer},
+		{name: "Ungrouped", s: "1500m", want: 1500 * Meter},
+		{name: "Decimal Comma", s: "1,5m", wantErr: true},
+		{name: "Short Group", s: "1,00m", wantErr: true},
+		{name: "Long Group", s: "1,0000m", wantErr: true},
+		{name: "Long Leading Group", s: "1000,000m", wantErr: true},
+		{name: "Leading Comma", s: ",000m", wantErr: true},
+		{name: "Trailing Comma", s: "1,m", wantErr: true},
+		{name: "Double Comma", s: "1,,000m", wantErr: true},
+		{name: "Comma In Fraction", s: "0.000,5m", wantErr: true},
+		{name: "Comma In Exponent", s: "1e1,000m", wantErr: true},
+		{name: "Comma Between Terms", s: "5ft,11in", wantErr: true},
+		{name: "Leading Underscore", s: "_1000m", wantErr: true},
+		{name: "Double Underscore", s: "1__000m", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDistanceGrouped(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDistanceGrouped() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var perr *ParseError
+			if tt.wantErr && (!errors.As(err, &perr) || perr.Input != tt.s) {
+				t.Errorf("ParseDistanceGrouped() error = %#v, want *ParseError for %q", err, tt.s)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDistanceGrouped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// ParseDistance itself still rejects grouped numbers.
+	for _, s := range []string{"1,000m", "1_000m"} {
+		if _, err := ParseDistance(s); err == nil {
+			t.Errorf("ParseDistance(%q) error = nil, want error", s)
+		}
+	}
+}
+
+func TestParseDistanceFloat(t *testing.T) {
+	type args struct {
+		s    string
+		unit Distance
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    float64
+		wantErr bool
+	}{
+		{
+			name: "Kilometers In Meters",
+			args: args{s: "2km", unit: Meter},
+			want: 2000,
+		},
+		{
+			name: "Meters In Kilometers",
+			args: args{s: "250m", unit: Kilometer},
+			want: 0.25,
+		},
+		{
+			name: "Feet And Inches In Inches",
+			args: args{s: "5ft11in", unit: Inch},
+			want: 71,
+		},
+		{
+			name: "Miles In Feet",
+			args: args{s: "-1mi", unit: Feet},
+			want: -5280,
+		},
+		{
+			name: "Millimeters In Nanometers",
+			args: args{s: "1.5mm", unit: Nanometer},
+			want: 1.5e6,
+		},
+		{
+			name: "Parsecs In Lightyears",
+			args: args{s: "1pc", unit: Lightyear},
+			want: float64(Parsec / Lightyear),
+		},
+		{
+			name:    "Invalid Distance",
+			args:    args{s: "2furlongs", unit: Meter},
+			wantErr: true,
+		},
+		{
+			name:    "Zero Unit",
+			args:    args{s: "2km", unit: 0},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDistanceFloat(tt.args.s, tt.args.unit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDistanceFloat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if math.Abs(got-tt.want) > 1e-9*math.Abs(tt.want) {
+				t.Errorf("ParseDistanceFloat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_Parse(t *testing.T) {
+	metre := func(suffix string) (float64, bool) {
+		switch suffix {
+		case "meter", "metre":
+			return 1, true
+		}
+		return 0, false
+	}
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		p       Parser
+		args    args
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name: "Empty",
+			p:    Parser{},
+			args: args{
+				s: "",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Blank",
+			p:    Parser{},
+			args: args{
+				s: "   ",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Empty As Zero",
+			p:    Parser{EmptyAsZero: true},
+			args: args{
+				s: "",
+			},
+			want:    Distance(0),
+			wantErr: false,
+		},
+		{
+			name: "Blank As Zero",
+			p:    Parser{EmptyAsZero: true},
+			args: args{
+				s: " \t\n ",
+			},
+			want:    Distance(0),
+			wantErr: false,
+		},
+		{
+			name: "Empty As Zero Still Parses",
+			p:    Parser{EmptyAsZero: true},
+			args: args{
+				s: "2m",
+			},
+			want:    Distance(2 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Empty As Zero Sign Only",
+			p:    Parser{EmptyAsZero: true},
+			args: args{
+				s: "-",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Known Unit",
+			p:    Parser{OnUnknownUnit: metre},
+			args: args{
+				s: "12km",
+			},
+			want:    Distance(12 * Kilometer),
+			wantErr: false,
+		},
+		{
+			name: "Meter Spelling",
+			p:    Parser{OnUnknownUnit: metre},
+			args: args{
+				s: "12meter",
+			},
+			want:    Distance(12 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Metre Spelling",
+			p:    Parser{OnUnknownUnit: metre},
+			args: args{
+				s: "1.5metre",
+			},
+			want:    Distance(1.5 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Mixed Units",
+			p:    Parser{OnUnknownUnit: metre},
+			args: args{
+				s: "1km2metre",
+			},
+			want:    Distance(1*Kilometer + 2*Meter),
+			wantErr: false,
+		},
+		{
+			name: "Rejected Unit",
+			p:    Parser{OnUnknownUnit: metre},
+			args: args{
+				s: "12meters",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Underscores",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "1_000m",
+			},
+			want:    Distance(1000 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Fraction Underscores",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "1_000.2_5m",
+			},
+			want:    Distance(1000.25 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Leading Underscore",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "_1m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Trailing Underscore",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "1_m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Underscore Before Point",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "1_.5m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Double Underscore",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "1__000m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Lone Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "km",
+			},
+			want:    Distance(1 * Kilometer),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Signed Lone Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "-ft6in",
+			},
+			want:    Distance(-1*Feet - 6*Inch),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Lone Exponent",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "e5m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Lone Upper Exponent",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "E3km",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Lone Unknown Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "furlong",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Leading Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "km 10",
+			},
+			want:    Distance(10 * Kilometer),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Leading Unit Signed Fraction",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "mi\t-1.5",
+			},
+			want:    Distance(-1.5 * Mile),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Signed Leading Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "-km 10",
+			},
+			want:    Distance(-10 * Kilometer),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Leading Unit Signed Number",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "km -10",
+			},
+			want:    Distance(-10 * Kilometer),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Plus Leading Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "+km 10",
+			},
+			want:    Distance(10 * Kilometer),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Signed Lone Leading Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "-km",
+			},
+			want:    Distance(-1 * Kilometer),
+			wantErr: false,
+		},
+		{
+			name: "Lenient Sign Before Unit And Number",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "-km -10",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Double Sign After Leading Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "km --10",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Leading And Trailing Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "km 10m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Leading And Interior Unit",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "km 1m 2",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Lenient Leading Unit With Exponent",
+			p:    Parser{Lenient: true},
+			args: args{
+				s: "m 1e3",
+			},
+			want:    Distance(1000 * Meter),
+			wantErr: false,
+		},
+		{
+			name: "Strict Leading Unit",
+			p:    Parser{},
+			args: args{
+				s: "km 10",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Strict Lone Unit",
+			p:    Parser{},
+			args: args{
+				s: "km",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "Strict Underscores",
+			p:    Parser{},
+			args: args{
+				s: "1_000m",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+		{
+			name: "No Callback",
+			p:    Parser{},
+			args: args{
+				s: "12meter",
+			},
+			want:    Distance(0),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.Parse(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Parser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEngineering(t *testing.T) {
+	type args struct {
+		s    string
+		base Distance
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name:    "Kilo",
+			args:    args{s: "1.5k", base: Meter},
+			want:    1500 * Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Mega",
+			args:    args{s: "3M", base: Meter},
+			want:    3e6 * Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Milli",
+			args:    args{s: "250m", base: Meter},
+			want:    250 * Millimeter,
+			wantErr: false,
+		},
+		{
+			name:    "Micro",
+			args:    args{s: "2u", base: Meter},
+			want:    2 * Micrometer,
+			wantErr: false,
+		},
+		{
+			name:    "Exponent",
+			args:    args{s: "1.5E3", base: Meter},
+			want:    1500 * Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Exponent And Prefix",
+			args:    args{s: "2e3k", base: Feet},
+			want:    2e6 * Feet,
+			wantErr: false,
+		},
+		{
+			name:    "No Prefix",
+			args:    args{s: "-12", base: Inch},
+			want:    -12 * Inch,
+			wantErr: false,
+		},
+		{
+			name:    "Unknown Prefix",
+			args:    args{s: "12x", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Prefix Only",
+			args:    args{s: "k", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Not A Number",
+			args:    args{s: "Inf", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEngineering(tt.args.s, tt.args.base)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseEngineering() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseEngineering() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetSystemPrecision(t *testing.T) {
+	type args struct {
+		s    System
+		prec int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		d      Distance
+		want   string
+		before func()
+	}{
+		{
+			name:   "Metric - 2 Decimals",
+			args:   args{s: Metric, prec: 2},
+			d:      Distance(1.5 * Meter),
+			want:   "1.50m",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Metric - Shortest",
+			args:   args{s: Metric, prec: -1},
+			d:      Distance(1.5 * Meter),
+			want:   "1.5m",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Imperial - 4 Decimals",
+			args:   args{s: Imperial, prec: 4},
+			d:      Distance(1.5 * Feet),
+			want:   "1.5000ft",
+			before: func() { UseImperial() },
+		},
+		{
+			name:   "Imperial Precision Unused By Metric",
+			args:   args{s: Imperial, prec: 4},
+			d:      Distance(1.5 * Meter),
+			want:   "1.500000m",
+			before: func() { UseMetric() },
+		},
+		{
+			name: "Switching Systems",
+			args: args{s: Metric, prec: 1},
+			d:    Distance(1.5 * Feet),
+			want: "1.500ft",
+			before: func() {
+				SetSystemPrecision(Imperial, 3)
+				UseMetric()
+				ToggleUnits()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer SetSystemPrecision(Metric, 6)
+			defer SetSystemPrecision(Imperial, 6)
+			tt.before()
+			SetSystemPrecision(tt.args.s, tt.args.prec)
+			if got := tt.d.String(); got != tt.want {
+				t.Errorf("Distance.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	UseMetric()
+}
+
+func TestSetSystemPrecision_Concurrent(t *testing.T) {
+	defer SetSystemPrecision(Metric, 6)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				SetSystemPrecision(Metric, j%4)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := ParseDistance(Meter.String()); err != nil {
+					t.Errorf("ParseDistance(Distance.String()) error = %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNextLargerUnit(t *testing.T) {
+	UseMetric()
+	var got []string
+	unit := Picometer
+	for {
+		next, symbol, ok := NextLargerUnit(unit)
+		if !ok {
+			break
+		}
+		got = append(got, symbol)
+		unit = next
+	}
+	want := []string{"nm", "µm", "mm", "cm", "m", "km", "au", "pc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NextLargerUnit() steps = %q, want %q", got, want)
+	}
+	if unit != Parsec {
+		t.Errorf("NextLargerUnit() ended at %v, want %v", unit, Parsec)
+	}
+	if _, _, ok := NextLargerUnit(NauticalMile); ok {
+		t.Errorf("NextLargerUnit(NauticalMile) ok = true, want false for a unit not in the ladder")
+	}
+	UseImperial()
+	defer UseMetric()
+	if next, symbol, ok := NextLargerUnit(Inch); !ok || next != Feet || symbol != "ft" {
+		t.Errorf("NextLargerUnit(Inch) = %v, %v, %v, want %v, ft, true", next, symbol, ok, Feet)
+	}
+}
+
+func TestNextSmallerUnit(t *testing.T) {
+	UseMetric()
+	var got []string
+	unit := Distance(Parsec)
+	for {
+		next, symbol, ok := NextSmallerUnit(unit)
+		if !ok {
+			break
+		}
+		got = append(got, symbol)
+		unit = next
+	}
+	want := []string{"au", "km", "m", "cm", "mm", "µm", "nm", "pm"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NextSmallerUnit() steps = %q, want %q", got, want)
+	}
+	if unit != Picometer {
+		t.Errorf("NextSmallerUnit() ended at %v, want %v", unit, Picometer)
+	}
+	UseImperial()
+	defer UseMetric()
+	if _, _, ok := NextSmallerUnit(Inch); ok {
+		t.Errorf("NextSmallerUnit(Inch) ok = true, want false at the end of the ladder")
+	}
+}
+
+func TestSuffix(t *testing.T) {
+	tests := []struct {
+		unit   Distance
+		want   string
+		wantOk bool
+	}{
+		{Nanometer, "nm", true},
+		{Micrometer, "µm", true},
+		{Millimeter, "mm", true},
+		{Centimeter, "cm", true},
+		{Meter, "m", true},
+		{Kilometer, "km", true},
+		{Inch, "in", true},
+		{Feet, "ft", true},
+		{Yard, "yd", true},
+		{Mile, "mi", true},
+		{NauticalMile, "nmi", true},
+		{Lightyear, "ly", true},
+		{AstronomicalUnit, "au", true},
+		{Parsec, "pc", true},
+		{2 * Meter, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, ok := Suffix(tt.unit)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Suffix() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			back, err := ParseDistance("1" + got)
+			if err != nil || back != tt.unit {
+				t.Errorf("ParseDistance(%q) = %v, %v, want %v", "1"+got, back, err, tt.unit)
+			}
+		})
+	}
+}
+
+func TestDistance_FormatIn(t *testing.T) {
+	type args struct {
+		unit Distance
+		prec int
+	}
+	tests := []struct {
+		name string
+		d    Distance
+		args args
+		want string
+	}{
+		{name: "Kilometers", d: 2500 * Meter, args: args{unit: Kilometer, prec: 3}, want: "2.500km"},
+		{name: "Tiny In Kilometers", d: Meter, args: args{unit: Kilometer, prec: 3}, want: "0.001km"},
+		{name: "Nanometers", d: 1.5 * Micrometer, args: args{unit: Nanometer, prec: 0}, want: "1500nm"},
+		{name: "Micrometers", d: Millimeter, args: args{unit: Micrometer, prec: 1}, want: "1000.0µm"},
+		{name: "Millimeters", d: Inch, args: args{unit: Millimeter, prec: 1}, want: "25.4mm"},
+		{name: "Centimeters", d: Meter, args: args{unit: Centimeter, prec: 0}, want: "100cm"},
+		{name: "Meters", d: -Kilometer, args: args{unit: Meter, prec: 2}, want: "-1000.00m"},
+		{name: "Inches", d: Feet, args: args{unit: Inch, prec: 0}, want: "12in"},
+		{name: "Feet", d: Yard, args: args{unit: Feet, prec: 1}, want: "3.0ft"},
+		{name: "Yards", d: Mile, args: args{unit: Yard, prec: 0}, want: "1760yd"},
+		{name: "Miles", d: 2640 * Feet, args: args{unit: Mile, prec: 2}, want: "0.50mi"},
+		{name: "Nautical Miles", d: 3704 * Meter, args: args{unit: NauticalMile, prec: 1}, want: "2.0nmi"},
+		{name: "Lightyears", d: 2 * Lightyear, args: args{unit: Lightyear, prec: 0}, want: "2ly"},
+		{name: "Astronomical Units", d: AstronomicalUnit / 2, args: args{unit: AstronomicalUnit, prec: 1}, want: "0.5au"},
+		{name: "Parsecs", d: 3 * Parsec, args: args{unit: Parsec, prec: -1}, want: "3pc"},
+		{name: "No Suffix", d: Meter, args: args{unit: 2 * Meter, prec: 0}, want: "1000000000nm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.FormatIn(tt.args.unit, tt.args.prec); got != tt.want {
+				t.Errorf("Distance.FormatIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpellings(t *testing.T) {
+	type args struct {
+		canonical string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Micrometer",
+			args: args{canonical: "µm"},
+			want: []string{"um", "µm", "μm"},
+		},
+		{
+			name: "Micrometer Alternate Spelling",
+			args: args{canonical: "um"},
+			want: []string{"um", "µm", "μm"},
+		},
+		{
+			name: "Angstrom",
+			args: args{canonical: "A"},
+			want: []string{"A", "Å", "Å"},
+		},
+		{
+			name: "Single Spelling",
+			args: args{canonical: "km"},
+			want: []string{"km"},
+		},
+		{
+			name: "Unknown",
+			args: args{canonical: "furlong"},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Spellings(tt.args.canonical)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Spellings() = %q, want %q", got, tt.want)
+			}
+			want, _ := ParseDistance("1.5" + tt.args.canonical)
+			for _, u := range got {
+				d, err := ParseDistance("1.5" + u)
+				if err != nil {
+					t.Errorf("ParseDistance(%q) error = %v", "1.5"+u, err)
+				}
+				if d != want {
+					t.Errorf("ParseDistance(%q) = %v, want %v", "1.5"+u, d, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSexagesimal(t *testing.T) {
+	type args struct {
+		s    string
+		base Distance
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name:    "Half A Meter",
+			args:    args{s: "5m 30", base: Feet},
+			want:    5.5 * Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Base Unit",
+			args:    args{s: "2 15", base: Mile},
+			want:    2.25 * Mile,
+			wantErr: false,
+		},
+		{
+			name:    "Fractional Minutes",
+			args:    args{s: "1km 7.5", base: Meter},
+			want:    1.125 * Kilometer,
+			wantErr: false,
+		},
+		{
+			name:    "No Minutes",
+			args:    args{s: "3yd", base: Meter},
+			want:    3 * Yard,
+			wantErr: false,
+		},
+		{
+			name:    "Negative",
+			args:    args{s: "-1m 30", base: Meter},
+			want:    -1.5 * Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Minutes Out Of Range",
+			args:    args{s: "1m 60", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Negative Minutes",
+			args:    args{s: "1m -30", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Unit",
+			args:    args{s: "1furlong 30", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Too Many Fields",
+			args:    args{s: "1m 30 30", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Empty",
+			args:    args{s: " ", base: Meter},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSexagesimal(tt.args.s, tt.args.base)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSexagesimal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseSexagesimal() = %v, want %v", got, tt.want)
 			}
 		})
 	}
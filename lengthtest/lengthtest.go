@@ -0,0 +1,93 @@
+// Package lengthtest provides utilities for testing code that handles distances.
+package lengthtest
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/penguingovernor/length"
+)
+
+// A Fixture is a distance string along with the distance it parses to.
+type Fixture struct {
+	In   string
+	Want length.Distance
+}
+
+// TestFixtures returns a reference set of distance strings that
+// length.ParseDistance accepts, along with the distances they parse to.
+// Projects that parse or store distances can run their own handling
+// against the same cases. The set is checked against the length package
+// by its own tests, so it stays valid as the package changes.
+func TestFixtures() []Fixture {
+	return []Fixture{
+		{"0", 0},
+		{"0m", 0},
+		{"12m", 12 * length.Meter},
+		{"-1nm", -1 * length.Nanometer},
+		{"+3in", 3 * length.Inch},
+		{".5m", 0.5 * length.Meter},
+		{"1.5km", 1.5 * length.Kilometer},
+		{"2.54cm", 2.54 * length.Centimeter},
+		{"7µm", 7 * length.Micrometer},
+		{"7um", 7 * length.Micrometer},
+		{"100.5yd", 100.5 * length.Yard},
+		{"-1.9mi", -1.9 * length.Mile},
+		{"5ft11in", 5*length.Feet + 11*length.Inch},
+		{"1km250m", 1250 * length.Meter},
+		{"26.2mi", 26.2 * length.Mile},
+		{"1ly", length.Lightyear},
+	}
+}
+
+// reparseTolerance is the relative difference allowed between a distance
+// and the distance obtained by formatting and parsing it again, which
+// covers the rounding of the conversion to and from the printed unit.
+const reparseTolerance = 1e-12
+
+// reparseFloor is the absolute difference, in nanometers, always allowed
+// between a distance and the distance obtained by formatting and parsing
+// it again. Converting distances smaller than this to units as large as
+// an inch leaves a subnormal number, or zero, that has lost precision.
+const reparseFloor = 1e-290
+
+// CheckParseInvariants parses s with length.ParseDistance and reports
+// an error if the parser breaks one of its invariants:
+//
+//   - it must not panic;
+//   - it must either fail and return zero, or succeed and return
+//     a distance that is not NaN;
+//   - a distance it returns must print, in the shortest exact form
+//     of either unit system, to a string that parses back to the
+//     same distance, up to floating-point rounding.
+//
+// It returns nil for any input, valid or not, that keeps them.
+// It is meant to be called from fuzz targets and property tests.
+func CheckParseInvariants(s string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("lengthtest: ParseDistance(%q) panicked: %v", s, r)
+		}
+	}()
+	d, perr := length.ParseDistance(s)
+	if perr != nil {
+		if d != 0 {
+			return fmt.Errorf("lengthtest: ParseDistance(%q) = %v with error %v, want 0", s, float64(d), perr)
+		}
+		return nil
+	}
+	if math.IsNaN(float64(d)) {
+		return fmt.Errorf("lengthtest: ParseDistance(%q) = NaN", s)
+	}
+	for _, sys := range []length.System{length.Metric, length.Imperial} {
+		out := string(length.AppendDistance(nil, d, sys, -1))
+		got, perr := length.ParseDistance(out)
+		if perr != nil {
+			return fmt.Errorf("lengthtest: ParseDistance(%q) = %v, which prints as %q that does not parse: %v", s, float64(d), out, perr)
+		}
+		if diff := math.Abs(float64(got - d)); diff > reparseTolerance*math.Abs(float64(d)) && diff > reparseFloor {
+			return fmt.Errorf("lengthtest: ParseDistance(%q) = %v, which prints as %q that parses to %v", s, float64(d), out, float64(got))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package lengthtest
+
+import (
+	"testing"
+
+	"github.com/penguingovernor/length"
+)
+
+func TestTestFixtures(t *testing.T) {
+	for _, tt := range TestFixtures() {
+		t.Run(tt.In, func(t *testing.T) {
+			got, err := length.ParseDistance(tt.In)
+			if err != nil {
+				t.Fatalf("ParseDistance() error = %v", err)
+			}
+			if got != tt.Want {
+				t.Errorf("ParseDistance() = %v, want %v", got, tt.Want)
+			}
+		})
+	}
+}
+
+func TestCheckParseInvariants(t *testing.T) {
+	tests := []string{
+		"",
+		"-",
+		"+",
+		".",
+		"-.m",
+		"m",
+		"1",
+		"1.",
+		"1.m",
+		"-0m",
+		"00012m",
+		"1e3m",
+		"1..5m",
+		"5ft11",
+		"5ft-11in",
+		"9223372036854775807nm",
+		"9223372036854775808nm",
+		"99999999999999999999m",
+		"1.9e283ly",
+		"1.91e283ly",
+		"1e-320nm",
+		"0.00000000000000000000000001nm",
+		"1µ",
+		"\xffm",
+		"1m\x00",
+		" 1m",
+		"1 m",
+	}
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			if err := CheckParseInvariants(s); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func FuzzParseDistance(f *testing.F) {
+	for _, tt := range TestFixtures() {
+		f.Add(tt.In)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if err := CheckParseInvariants(s); err != nil {
+			t.Error(err)
+		}
+	})
+}
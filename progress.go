@@ -0,0 +1,40 @@
+package length
+
+import (
+	"math"
+	"strconv"
+)
+
+// A Progress tracks how much of a Total distance, such as a route,
+// has been covered.
+// A Progress with a Total of zero (or less) is always complete.
+type Progress struct {
+	Total Distance
+}
+
+// Fraction returns the fraction of the total covered by done,
+// clamped to the range [0, 1].
+func (p Progress) Fraction(done Distance) float64 {
+	if p.Total <= 0 {
+		return 1
+	}
+	return math.Max(0, math.Min(1, float64(done/p.Total)))
+}
+
+// Remaining returns the distance left to cover after done,
+// which is never less than zero.
+func (p Progress) Remaining(done Distance) Distance {
+	if r := p.Total - done; r > 0 {
+		return r
+	}
+	return 0
+}
+
+// String returns a string representing the progress after done, such as
+// "2.0km / 5.0km (40%)". Both distances are printed with one digit after
+// the decimal point, in the unit that String would use for the total.
+func (p Progress) String(done Distance) string {
+	u := bestUnit(p.Total, currentSystem())
+	return done.formatIn(u, 1) + " / " + p.Total.formatIn(u, 1) +
+		" (" + strconv.Itoa(int(math.Round(100*p.Fraction(done)))) + "%)"
+}
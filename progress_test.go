@@ -0,0 +1,69 @@
+package length
+
+import "testing"
+
+func TestProgress(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             Progress
+		done          Distance
+		wantFraction  float64
+		wantRemaining Distance
+		wantString    string
+	}{
+		{
+			name:          "Partial",
+			p:             Progress{Total: 5 * Meter},
+			done:          2 * Meter,
+			wantFraction:  0.4,
+			wantRemaining: 3 * Meter,
+			wantString:    "2.0m / 5.0m (40%)",
+		},
+		{
+			name:          "Not Started",
+			p:             Progress{Total: 5 * Meter},
+			done:          0,
+			wantFraction:  0,
+			wantRemaining: 5 * Meter,
+			wantString:    "0.0m / 5.0m (0%)",
+		},
+		{
+			name:          "Complete",
+			p:             Progress{Total: 50 * Centimeter},
+			done:          50 * Centimeter,
+			wantFraction:  1,
+			wantRemaining: 0,
+			wantString:    "50.0cm / 50.0cm (100%)",
+		},
+		{
+			name:          "Over Complete",
+			p:             Progress{Total: 5 * Meter},
+			done:          6 * Meter,
+			wantFraction:  1,
+			wantRemaining: 0,
+			wantString:    "6.0m / 5.0m (100%)",
+		},
+		{
+			name:          "Zero Total",
+			p:             Progress{},
+			done:          0,
+			wantFraction:  1,
+			wantRemaining: 0,
+			wantString:    "0.0m / 0.0m (100%)",
+		},
+	}
+	UseMetric()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Fraction(tt.done); got != tt.wantFraction {
+				t.Errorf("Progress.Fraction() = %v, want %v", got, tt.wantFraction)
+			}
+			if got := tt.p.Remaining(tt.done); got != tt.wantRemaining {
+				t.Errorf("Progress.Remaining() = %v, want %v", got, tt.wantRemaining)
+			}
+			if got := tt.p.String(tt.done); got != tt.wantString {
+				t.Errorf("Progress.String() = %v, want %v", got, tt.wantString)
+			}
+		})
+	}
+}
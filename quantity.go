@@ -0,0 +1,30 @@
+package length
+
+import "errors"
+
+// A Quantity is a distance expressed as a number of some unit.
+// It encodes to JSON as an object such as {"value":1.5,"unit":"km"}.
+type Quantity struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// Quantity returns the distance as a number of the unit of the
+// system s that String would use to print it.
+func (d Distance) Quantity(s System) Quantity {
+	u := bestUnit(d, s)
+	return Quantity{
+		Value: float64(d) / float64(u.unit),
+		Unit:  u.symbol,
+	}
+}
+
+// Distance returns the distance represented by q.
+// The unit must be one of the units accepted by ParseDistance.
+func (q Quantity) Distance() (Distance, error) {
+	unit, ok := unitMap[q.Unit]
+	if !ok {
+		return 0, errors.New("length: unknown unit " + q.Unit + " in quantity")
+	}
+	return Distance(q.Value * unit), nil
+}
@@ -0,0 +1,108 @@
+package length
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDistance_Quantity(t *testing.T) {
+	type args struct {
+		s System
+	}
+	tests := []struct {
+		name     string
+		d        Distance
+		args     args
+		want     Quantity
+		wantJSON string
+	}{
+		{
+			name:     "Metric - 1.5 Meters",
+			d:        1.5 * Meter,
+			args:     args{s: Metric},
+			want:     Quantity{Value: 1.5, Unit: "m"},
+			wantJSON: `{"value":1.5,"unit":"m"}`,
+		},
+		{
+			name:     "Metric - 25 Millimeters",
+			d:        25 * Millimeter,
+			args:     args{s: Metric},
+			want:     Quantity{Value: 2.5, Unit: "cm"},
+			wantJSON: `{"value":2.5,"unit":"cm"}`,
+		},
+		{
+			name:     "Metric - Zero",
+			d:        0,
+			args:     args{s: Metric},
+			want:     Quantity{Value: 0, Unit: "m"},
+			wantJSON: `{"value":0,"unit":"m"}`,
+		},
+		{
+			name:     "Imperial - Negative 2 Feet",
+			d:        -2 * Feet,
+			args:     args{s: Imperial},
+			want:     Quantity{Value: -2, Unit: "ft"},
+			wantJSON: `{"value":-2,"unit":"ft"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.Quantity(tt.args.s)
+			if got != tt.want {
+				t.Errorf("Distance.Quantity() = %v, want %v", got, tt.want)
+			}
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(b) != tt.wantJSON {
+				t.Errorf("json.Marshal() = %s, want %s", b, tt.wantJSON)
+			}
+			var q Quantity
+			if err := json.Unmarshal(b, &q); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			d, err := q.Distance()
+			if err != nil {
+				t.Fatalf("Quantity.Distance() error = %v", err)
+			}
+			if d != tt.d {
+				t.Errorf("Quantity.Distance() = %v, want %v", d, tt.d)
+			}
+		})
+	}
+}
+
+func TestQuantity_Distance(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       Quantity
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name:    "Kilometers",
+			q:       Quantity{Value: 1.5, Unit: "km"},
+			want:    1.5 * Kilometer,
+			wantErr: false,
+		},
+		{
+			name:    "Unknown Unit",
+			q:       Quantity{Value: 1.5, Unit: "furlong"},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.q.Distance()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Quantity.Distance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Quantity.Distance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package length
+
+import (
+	"iter"
+	"math"
+)
+
+// A Range is the span of distances between Lo and Hi.
+type Range struct {
+	Lo, Hi Distance
+}
+
+// Steps returns an iterator over the distances from r.Lo to r.Hi spaced
+// step apart: r.Lo, r.Lo+step, r.Lo+2*step and so on, up to and including
+// r.Hi if it falls on a step. For example:
+//
+//	for d := range length.Range{Lo: 0, Hi: 10 * length.Meter}.Steps(length.Meter) {
+//		fmt.Println(d)
+//	}
+//
+// If r.Hi is less than r.Lo the distances decrease from r.Lo to r.Hi;
+// the sign of step is ignored. A zero step yields nothing.
+// To avoid accumulating round-off, each distance is computed as r.Lo plus
+// a multiple of step, and r.Hi is included if it is within one part in
+// a billion of a step.
+func (r Range) Steps(step Distance) iter.Seq[Distance] {
+	return func(yield func(Distance) bool) {
+		if step < 0 {
+			step = -step
+		}
+		n := math.Abs(float64((r.Hi - r.Lo) / step))
+		if step == 0 || math.IsInf(n, 0) || math.IsNaN(n) {
+			return
+		}
+		if r.Hi < r.Lo {
+			step = -step
+		}
+		n = math.Floor(n + multipleTolerance)
+		for i := 0.0; i <= n; i++ {
+			if !yield(r.Lo + Distance(i)*step) {
+				return
+			}
+		}
+	}
+}
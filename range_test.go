@@ -0,0 +1,85 @@
+package length
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRange_Steps(t *testing.T) {
+	type args struct {
+		step Distance
+	}
+	tests := []struct {
+		name string
+		r    Range
+		args args
+		want []Distance
+	}{
+		{
+			name: "Ascending",
+			r:    Range{Lo: 0, Hi: 3 * Meter},
+			args: args{step: Meter},
+			want: []Distance{0, Meter, 2 * Meter, 3 * Meter},
+		},
+		{
+			name: "Not A Multiple Of Step",
+			r:    Range{Lo: Meter, Hi: 3.5 * Meter},
+			args: args{step: Meter},
+			want: []Distance{Meter, 2 * Meter, 3 * Meter},
+		},
+		{
+			name: "Round-off",
+			r:    Range{Lo: 0, Hi: 0.3 * Nanometer},
+			args: args{step: 0.1 * Nanometer},
+			want: []Distance{0, 0.1 * Nanometer, 0.2 * Nanometer, 0.30000000000000004 * Nanometer},
+		},
+		{
+			name: "Reversed",
+			r:    Range{Lo: 2 * Meter, Hi: -Meter},
+			args: args{step: Meter},
+			want: []Distance{2 * Meter, Meter, 0, -Meter},
+		},
+		{
+			name: "Negative Step",
+			r:    Range{Lo: 0, Hi: 2 * Feet},
+			args: args{step: -Feet},
+			want: []Distance{0, Feet, 2 * Feet},
+		},
+		{
+			name: "Empty Range",
+			r:    Range{Lo: Meter, Hi: Meter},
+			args: args{step: Centimeter},
+			want: []Distance{Meter},
+		},
+		{
+			name: "Zero Step",
+			r:    Range{Lo: 0, Hi: Meter},
+			args: args{step: 0},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Distance
+			for d := range tt.r.Steps(tt.args.step) {
+				got = append(got, d)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Range.Steps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Steps_Break(t *testing.T) {
+	var got []Distance
+	for d := range (Range{Lo: 0, Hi: Kilometer}).Steps(Meter) {
+		if d > 2*Meter {
+			break
+		}
+		got = append(got, d)
+	}
+	if want := []Distance{0, Meter, 2 * Meter}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range.Steps() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+package length
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Ruler returns a two-line drawing of a ruler of length total, width
+// characters wide, for use in terminals and debug output. The first line
+// has a bar at every multiple of majorEvery and an apostrophe at every
+// other multiple of minorEvery; the second labels the major ticks in the unit
+// of the system selected by ToggleUnits that String would use for
+// majorEvery. A label that would overlap the one before it is left out.
+// For example, Ruler(2*Meter, Meter, 50*Centimeter, 21) returns
+//
+//	|----'----|----'----|
+//	0m        1m       2m
+//
+// If total is not a multiple of majorEvery, the ruler extends past the
+// last tick. No minor ticks are drawn if minorEvery is not positive.
+// Ruler returns "" if total or majorEvery is not positive or width is
+// less than two.
+func Ruler(total, majorEvery, minorEvery Distance, width int) string {
+	if total <= 0 || majorEvery <= 0 || width < 2 {
+		return ""
+	}
+	col := func(d Distance) int {
+		return int(math.Round(float64(d/total) * float64(width-1)))
+	}
+	// count returns the number of whole steps in total, allowing for
+	// round-off as IsMultipleOf does.
+	count := func(step Distance) int {
+		q := float64(total / step)
+		return int(math.Floor(q + multipleTolerance*math.Max(1, q)))
+	}
+
+	ticks := []byte(strings.Repeat("-", width))
+	if minorEvery > 0 {
+		for i := 0; i <= count(minorEvery); i++ {
+			ticks[col(Distance(i)*minorEvery)] = '\''
+		}
+	}
+	u := bestUnit(majorEvery, currentSystem())
+	labels := []rune(strings.Repeat(" ", width))
+	free := 0 // first column not taken by a label
+	for i := 0; i <= count(majorEvery); i++ {
+		d := Distance(i) * majorEvery
+		c := col(d)
+		ticks[c] = '|'
+		label := []rune(strconv.FormatFloat(float64(d/u.unit), 'f', -1, 64) + u.symbol)
+		if c+len(label) > width {
+			c = width - len(label)
+		}
+		if c < free {
+			continue
+		}
+		copy(labels[c:], label)
+		free = c + len(label) + 1
+	}
+	return string(ticks) + "\n" + strings.TrimRight(string(labels), " ") + "\n"
+}
@@ -0,0 +1,66 @@
+package length
+
+import "testing"
+
+func TestRuler(t *testing.T) {
+	type args struct {
+		total      Distance
+		majorEvery Distance
+		minorEvery Distance
+		width      int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Two Meters",
+			args: args{total: 2 * Meter, majorEvery: Meter, minorEvery: 50 * Centimeter, width: 21},
+			want: "|----'----|----'----|\n" +
+				"0m        1m       2m\n",
+		},
+		{
+			name: "Not A Multiple",
+			args: args{total: 2.5 * Meter, majorEvery: Meter, minorEvery: 0, width: 26},
+			want: "|---------|---------|-----\n" +
+				"0m        1m        2m\n",
+		},
+		{
+			name: "Centimeters",
+			args: args{total: 30 * Centimeter, majorEvery: 10 * Centimeter, minorEvery: Centimeter, width: 31},
+			want: "|'''''''''|'''''''''|'''''''''|\n" +
+				"0cm       10cm      20cm   30cm\n",
+		},
+		{
+			name: "Overlapping Labels",
+			args: args{total: 3 * Meter, majorEvery: Meter, minorEvery: 0, width: 7},
+			want: "|-|-|-|\n" +
+				"0m  2m\n",
+		},
+		{
+			name: "Round Off",
+			args: args{total: 0.3 * Meter, majorEvery: 0.1 * Meter, minorEvery: 0, width: 13},
+			want: "|---|---|---|\n" +
+				"0cm 10cm 30cm\n",
+		},
+		{
+			name: "Zero Length",
+			args: args{total: 0, majorEvery: Meter, minorEvery: 0, width: 10},
+			want: "",
+		},
+		{
+			name: "Too Narrow",
+			args: args{total: Meter, majorEvery: Meter, minorEvery: 0, width: 1},
+			want: "",
+		},
+	}
+	UseMetric()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Ruler(tt.args.total, tt.args.majorEvery, tt.args.minorEvery, tt.args.width); got != tt.want {
+				t.Errorf("Ruler() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package length
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"sync/atomic"
+)
+
+// ErrNullDistance is returned by Distance.Scan for a NULL column value
+// after SetNullScanError(true).
+var ErrNullDistance = errors.New("length: cannot scan NULL into a Distance")
+
+// nullScanError is whether Distance.Scan rejects NULL. It is atomic
+// so that SetNullScanError may be called while other goroutines scan.
+var nullScanError atomic.Bool
+
+// SetNullScanError sets whether Distance.Scan returns ErrNullDistance
+// for a NULL column value. By default a NULL scans as the zero distance.
+// Use NullDistance to tell NULL apart from zero instead.
+// It is safe to call SetNullScanError concurrently with Scan.
+func SetNullScanError(on bool) {
+	nullScanError.Store(on)
+}
+
+// Scan implements the sql.Scanner interface.
+// Numbers (int64 or float64) are taken as nanometer counts and strings
+// (string or []byte) are parsed with ParseDistance.
+// A NULL value sets d to zero, or returns ErrNullDistance and leaves d
+// unchanged if SetNullScanError(true) has been called.
+func (d *Distance) Scan(value interface{}) error {
+	if value == nil {
+		if nullScanError.Load() {
+			return ErrNullDistance
+		}
+		*d = 0
+		return nil
+	}
+	v, err := scanDistance(value)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the canonical string used by Quote, such as "1.5e9nm",
+// which ParseDistance reads back exactly in either unit system.
+// An error is returned if d is infinite or NaN.
+func (d Distance) Value() (driver.Value, error) {
+	if math.IsInf(float64(d), 0) || math.IsNaN(float64(d)) {
+		return nil, errNonFinite
+	}
+	return d.canonical(), nil
+}
+
+// A NullDistance is a Distance that may be null, for use with database
+// columns that can hold NULL. It implements the sql.Scanner and
+// driver.Valuer interfaces in the same way as sql.NullFloat64.
+type NullDistance struct {
+	Distance Distance
+	Valid    bool // Valid is true if Distance is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+// A NULL value sets Valid to false. Any other value sets Valid to true:
+// numbers are taken as nanometer counts and strings are parsed with ParseDistance.
+func (n *NullDistance) Scan(value interface{}) error {
+	if value == nil {
+		n.Distance, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Distance.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil if n is not valid, and the value of Distance.Value otherwise,
+// so that nullable and non-nullable columns hold the same form.
+func (n NullDistance) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Distance.Value()
+}
+
+// scanDistance converts a database column value into a Distance.
+// Numbers (int64 or float64) are nanometer counts, and strings
+// (string or []byte) are parsed with ParseDistance.
+func scanDistance(value interface{}) (Distance, error) {
+	switch v := value.(type) {
+	case float64:
+		return Distance(v), nil
+	case int64:
+		return Distance(v), nil
+	case string:
+		return ParseDistance(v)
+	case []byte:
+		return ParseDistance(string(v))
+	}
+	return 0, fmt.Errorf("length: cannot scan %T into a Distance", value)
+}
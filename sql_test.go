@@ -0,0 +1,291 @@
+package length
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestNullDistance_Scan(t *testing.T) {
+	type args struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    NullDistance
+		wantErr bool
+	}{
+		{
+			name:    "NULL",
+			args:    args{value: nil},
+			want:    NullDistance{},
+			wantErr: false,
+		},
+		{
+			name:    "Float",
+			args:    args{value: float64(1.5e9)},
+			want:    NullDistance{Distance: 1.5 * Meter, Valid: true},
+			wantErr: false,
+		},
+		{
+			name:    "Integer",
+			args:    args{value: int64(2e6)},
+			want:    NullDistance{Distance: 2 * Millimeter, Valid: true},
+			wantErr: false,
+		},
+		{
+			name:    "String",
+			args:    args{value: "5ft11in"},
+			want:    NullDistance{Distance: 5*Feet + 11*Inch, Valid: true},
+			wantErr: false,
+		},
+		{
+			name:    "Bytes",
+			args:    args{value: []byte("-2km")},
+			want:    NullDistance{Distance: -2 * Kilometer, Valid: true},
+			wantErr: false,
+		},
+		{
+			name:    "Bad String",
+			args:    args{value: "2furlongs"},
+			want:    NullDistance{},
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported Type",
+			args:    args{value: true},
+			want:    NullDistance{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got NullDistance
+			if err := got.Scan(tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("NullDistance.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NullDistance.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDistance_Value(t *testing.T) {
+	tests := []struct {
+		name string
+		n    NullDistance
+		want driver.Value
+	}{
+		{
+			name: "NULL",
+			n:    NullDistance{Distance: Meter},
+			want: nil,
+		},
+		{
+			name: "Valid",
+			n:    NullDistance{Distance: 5*Feet + 11*Inch, Valid: true},
+			want: "1.8034e9nm",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.n.Value()
+			if err != nil {
+				t.Fatalf("NullDistance.Value() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NullDistance.Value() = %v, want %v", got, tt.want)
+			}
+			var n NullDistance
+			if err := n.Scan(got); err != nil {
+				t.Fatalf("NullDistance.Scan() error = %v", err)
+			}
+			if n.Valid != tt.n.Valid || n.Valid && n.Distance != tt.n.Distance {
+				t.Errorf("NullDistance.Scan() = %v, want %v", n, tt.n)
+			}
+		})
+	}
+}
+
+func TestDistance_Scan(t *testing.T) {
+	type args struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Distance
+		wantErr bool
+	}{
+		{
+			name:    "NULL",
+			args:    args{value: nil},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name:    "Float",
+			args:    args{value: float64(1.5e9)},
+			want:    1.5 * Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Integer",
+			args:    args{value: int64(2e6)},
+			want:    2 * Millimeter,
+			wantErr: false,
+		},
+		{
+			name:    "String",
+			args:    args{value: "5ft11in"},
+			want:    5*Feet + 11*Inch,
+			wantErr: false,
+		},
+		{
+			name:    "Canonical String",
+			args:    args{value: "1.5e9nm"},
+			want:    1.5 * Meter,
+			wantErr: false,
+		},
+		{
+			name:    "Bytes",
+			args:    args{value: []byte("-2km")},
+			want:    -2 * Kilometer,
+			wantErr: false,
+		},
+		{
+			name:    "Bad String",
+			args:    args{value: "2furlongs"},
+			want:    Meter,
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported Type",
+			args:    args{value: true},
+			want:    Meter,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Meter
+			if err := got.Scan(tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("Distance.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Distance.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetNullScanError(t *testing.T) {
+	SetNullScanError(true)
+	defer SetNullScanError(false)
+
+	d := Meter
+	if err := d.Scan(nil); !errors.Is(err, ErrNullDistance) {
+		t.Errorf("Distance.Scan(nil) error = %v, want %v", err, ErrNullDistance)
+	}
+	if d != Meter {
+		t.Errorf("Distance.Scan(nil) = %v, want %v unchanged", d, Meter)
+	}
+
+	// NullDistance still records NULL as invalid.
+	n := NullDistance{Distance: Meter, Valid: true}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("NullDistance.Scan(nil) = %v, %v, want invalid and no error", n, err)
+	}
+}
+
+func TestSetNullScanError_Concurrent(t *testing.T) {
+	defer SetNullScanError(false)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				SetNullScanError(j%2 == 0)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d := Meter
+				if err := d.Scan(nil); err != nil && !errors.Is(err, ErrNullDistance) {
+					t.Errorf("Distance.Scan(nil) error = %v, want nil or %v", err, ErrNullDistance)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDistance_Value(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distance
+		want driver.Value
+	}{
+		{
+			name: "Zero",
+			d:    0,
+			want: "0nm",
+		},
+		{
+			name: "Meters",
+			d:    1.5 * Meter,
+			want: "1.5e9nm",
+		},
+		{
+			name: "Feet And Inches",
+			d:    5*Feet + 11*Inch,
+			want: "1.8034e9nm",
+		},
+		{
+			name: "Negative",
+			d:    -Nanometer / 10,
+			want: "-0.1nm",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.Value()
+			if err != nil {
+				t.Fatalf("Distance.Value() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Distance.Value() = %v, want %v", got, tt.want)
+			}
+			for _, u := range []func(){UseMetric, UseImperial} {
+				u()
+				var d Distance
+				if err := d.Scan(got); err != nil {
+					t.Fatalf("Distance.Scan() error = %v", err)
+				}
+				if d != tt.d {
+					t.Errorf("Distance.Scan(%v) = %v, want %v", got, d, tt.d)
+				}
+			}
+			UseMetric()
+		})
+	}
+}
+
+func TestDistance_ValueNonFinite(t *testing.T) {
+	for _, d := range []Distance{Distance(math.Inf(1)), Distance(math.Inf(-1)), Distance(math.NaN())} {
+		if got, err := d.Value(); err == nil {
+			t.Errorf("Distance(%v).Value() = %v, want error", float64(d), got)
+		}
+		if got, err := (NullDistance{Distance: d, Valid: true}).Value(); err == nil {
+			t.Errorf("NullDistance{%v}.Value() = %v, want error", float64(d), got)
+		}
+	}
+}
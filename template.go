@@ -0,0 +1,31 @@
+package length
+
+import "text/template"
+
+// FuncMap returns template functions for printing distances, ready to be
+// registered with a text/template or html/template Template via Funcs.
+// The functions each take a Distance and print it with two decimals:
+//
+//	humanize  in the unit that String would choose, e.g. "1.50km"
+//	km, m     in kilometers or meters
+//	mi, ft    in miles or feet
+//	in        in inches
+//
+// For example, {{ km .Len }} prints the field Len in kilometers.
+func FuncMap() template.FuncMap {
+	in := func(unit Distance, symbol string) func(Distance) string {
+		return func(d Distance) string {
+			return d.formatIn(namedUnit{unit, symbol}, 2)
+		}
+	}
+	return template.FuncMap{
+		"humanize": func(d Distance) string {
+			return d.formatIn(bestUnit(d, currentSystem()), 2)
+		},
+		"km": in(Kilometer, "km"),
+		"m":  in(Meter, "m"),
+		"mi": in(Mile, "mi"),
+		"ft": in(Feet, "ft"),
+		"in": in(Inch, "in"),
+	}
+}
@@ -0,0 +1,59 @@
+package length
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestFuncMap(t *testing.T) {
+	type data struct {
+		Len Distance
+	}
+	tests := []struct {
+		name   string
+		text   string
+		data   data
+		want   string
+		before func()
+	}{
+		{
+			name:   "Humanize Metric",
+			text:   "{{ humanize .Len }}",
+			data:   data{Len: 25 * Millimeter},
+			want:   "2.50cm",
+			before: func() { UseMetric() },
+		},
+		{
+			name:   "Humanize Imperial",
+			text:   "{{ humanize .Len }}",
+			data:   data{Len: 18 * Inch},
+			want:   "1.50ft",
+			before: func() { UseImperial() },
+		},
+		{
+			name:   "Several Units",
+			text:   "{{ km .Len }} / {{ m .Len }} / {{ mi .Len }} / {{ ft .Len }} / {{ in .Len }}",
+			data:   data{Len: Mile},
+			want:   "1.61km / 1609.34m / 1.00mi / 5280.00ft / 63360.00in",
+			before: func() { UseMetric() },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+			tmpl, err := template.New(tt.name).Funcs(FuncMap()).Parse(tt.text)
+			if err != nil {
+				t.Fatalf("template.Parse() error = %v", err)
+			}
+			var b strings.Builder
+			if err := tmpl.Execute(&b, tt.data); err != nil {
+				t.Fatalf("Template.Execute() error = %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("Template.Execute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	UseMetric()
+}
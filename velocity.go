@@ -0,0 +1,115 @@
+package length
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Velocity represents a speed as a float64 count of meters per second.
+type Velocity float64
+
+// Speed returns the velocity of something that covers the distance d in
+// the duration t.
+// A zero duration yields +Inf or -Inf according to the sign of d,
+// unless d is also zero, in which case Speed returns 0.
+func Speed(d Distance, t time.Duration) Velocity {
+	if t == 0 {
+		if d == 0 {
+			return 0
+		}
+		return Velocity(math.Inf(int(math.Copysign(1, float64(d)))))
+	}
+	return Velocity(float64(d/Meter) / t.Seconds())
+}
+
+// MetersPerSecond returns the velocity as a floating point number of meters per second.
+func (v Velocity) MetersPerSecond() float64 {
+	return float64(v)
+}
+
+// KilometersPerHour returns the velocity as a floating point number of kilometers per hour.
+func (v Velocity) KilometersPerHour() float64 {
+	return float64(v) * float64(Meter/Kilometer) * 3600
+}
+
+// MilesPerHour returns the velocity as a floating point number of miles per hour.
+func (v Velocity) MilesPerHour() float64 {
+	return float64(v) * float64(Meter/Mile) * 3600
+}
+
+// FeetPerSecond returns the velocity as a floating point number of feet per second.
+func (v Velocity) FeetPerSecond() float64 {
+	return float64(v) * float64(Meter/Feet)
+}
+
+// String returns the velocity in meters per second, rounded to two
+// decimal places with trailing zeros removed, such as "28.72 m/s".
+func (v Velocity) String() string {
+	s := strconv.FormatFloat(float64(v), 'f', 2, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s + " m/s"
+}
+
+// velocityTimeUnits maps the time units accepted by ParseVelocity to
+// their durations.
+var velocityTimeUnits = map[string]time.Duration{
+	"ms":  time.Millisecond,
+	"s":   time.Second,
+	"min": time.Minute,
+	"h":   time.Hour,
+}
+
+// ParseVelocity parses a velocity string of the form distance/time,
+// such as "100km/h" or "-3.5ft/s".
+// The distance is anything accepted by ParseDistance and the
+// time unit is one of "ms", "s", "min" or "h".
+// The abbreviations "mph" and "kph" are also accepted as units,
+// as in "60mph".
+// Errors are of type *ParseError, as for ParseDistance.
+func ParseVelocity(s string) (Velocity, error) {
+	orig := s
+	switch {
+	case strings.HasSuffix(s, "mph"):
+		s = strings.TrimSuffix(s, "ph") + "i/h"
+	case strings.HasSuffix(s, "kph"):
+		s = strings.TrimSuffix(s, "ph") + "m/h"
+	}
+	i := strings.LastIndexByte(s, '/')
+	unit := ""
+	if i >= 0 {
+		unit = strings.TrimSpace(s[i+1:])
+	}
+	if unit == "" {
+		return 0, &ParseError{Input: orig, Err: ErrMissingUnit, noun: "velocity"}
+	}
+	per, ok := velocityTimeUnits[unit]
+	if !ok {
+		return 0, &ParseError{Input: orig, Part: unit, Err: ErrUnknownUnit, noun: "velocity"}
+	}
+	d, err := ParseDistance(strings.TrimSpace(s[:i]))
+	if err != nil {
+		return 0, err
+	}
+	return Speed(d, per), nil
+}
+
+// StoppingDistance returns the distance needed to bring something moving
+// at the velocity v to a halt under a constant deceleration of decel
+// meters per second squared, which is v²/(2·decel).
+// The direction of v does not matter.
+// If decel is zero or negative the object never stops,
+// and StoppingDistance returns +Inf.
+func StoppingDistance(v Velocity, decel float64) Distance {
+	if decel <= 0 {
+		return Distance(math.Inf(1))
+	}
+	mps := float64(v)
+	return Distance(mps*mps/(2*decel)) * Meter
+}
@@ -0,0 +1,200 @@
+package length
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSpeed(t *testing.T) {
+	type args struct {
+		d Distance
+		t time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want Velocity
+	}{
+		{
+			name: "Slug On Rocket",
+			args: args{d: 100.5 * Yard, t: 3200 * time.Millisecond},
+			want: 28.717875,
+		},
+		{
+			name: "Negative Distance",
+			args: args{d: -10 * Meter, t: 2 * time.Second},
+			want: -5,
+		},
+		{
+			name: "Zero Duration",
+			args: args{d: Meter, t: 0},
+			want: Velocity(math.Inf(1)),
+		},
+		{
+			name: "Negative Distance In Zero Duration",
+			args: args{d: -Meter, t: 0},
+			want: Velocity(math.Inf(-1)),
+		},
+		{
+			name: "Nothing In Zero Duration",
+			args: args{d: 0, t: 0},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Speed(tt.args.d, tt.args.t)
+			if got != tt.want && math.Abs(float64(got-tt.want)) > 1e-9 {
+				t.Errorf("Speed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVelocity_Units(t *testing.T) {
+	v := Speed(100.5*Yard, 3200*time.Millisecond)
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "Meters Per Second", got: v.MetersPerSecond(), want: 28.717875},
+		{name: "Kilometers Per Hour", got: v.KilometersPerHour(), want: 103.38435},
+		{name: "Miles Per Hour", got: v.MilesPerHour(), want: 64.24005681818181},
+		{name: "Feet Per Second", got: v.FeetPerSecond(), want: 94.21875},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-9 {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVelocity_String(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Velocity
+		want string
+	}{
+		{name: "Slug On Rocket", v: 28.717875, want: "28.72 m/s"},
+		{name: "Whole", v: 10, want: "10 m/s"},
+		{name: "One Decimal", v: 2.5, want: "2.5 m/s"},
+		{name: "Negative", v: -3.14159, want: "-3.14 m/s"},
+		{name: "Tiny Negative", v: -0.001, want: "0 m/s"},
+		{name: "Infinite", v: Velocity(math.Inf(1)), want: "+Inf m/s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.String(); got != tt.want {
+				t.Errorf("Velocity.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseVelocity(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Velocity
+		wantErr error
+	}{
+		{name: "Kilometers Per Hour", s: "100km/h", want: Velocity(100000.0 / 3600)},
+		{name: "Meters Per Second", s: "28.72m/s", want: 28.72},
+		{name: "Negative Feet Per Second", s: "-3.5ft/s", want: Velocity(-3.5 * 0.3048)},
+		{name: "Per Minute", s: "60m/min", want: 1},
+		{name: "Per Millisecond", s: "1mm/ms", want: 1},
+		{name: "Spaces Around Slash", s: "100km / h", want: Velocity(100000.0 / 3600)},
+		{name: "Miles Per Hour Abbreviation", s: "60mph", want: Velocity(60 * 1609.344 / 3600)},
+		{name: "Kilometers Per Hour Abbreviation", s: "36kph", want: 10},
+		{name: "Missing Time Unit", s: "100km", wantErr: ErrMissingUnit},
+		{name: "Empty Time Unit", s: "100km/ ", wantErr: ErrMissingUnit},
+		{name: "Unknown Time Unit", s: "100km/fortnight", wantErr: ErrUnknownUnit},
+		{name: "Bad Distance", s: "km/h", wantErr: ErrInvalidDistance},
+		{name: "Unknown Distance Unit", s: "100furlong/h", wantErr: ErrUnknownUnit},
+		{name: "Empty", s: "", wantErr: ErrMissingUnit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVelocity(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseVelocity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var perr *ParseError
+			if err != nil && !errors.As(err, &perr) {
+				t.Errorf("ParseVelocity() error = %T, want *ParseError", err)
+			}
+			if math.Abs(float64(got-tt.want)) > 1e-9 {
+				t.Errorf("ParseVelocity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseVelocity_ErrorText(t *testing.T) {
+	_, err := ParseVelocity("100km/fortnight")
+	if want := "length: unknown unit fortnight in velocity 100km/fortnight"; err == nil || err.Error() != want {
+		t.Errorf("ParseVelocity() error = %v, want %q", err, want)
+	}
+}
+
+func TestStoppingDistance(t *testing.T) {
+	type args struct {
+		v     Velocity
+		decel float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want Distance
+	}{
+		{
+			name: "Car At 20 Meters Per Second",
+			args: args{v: 20, decel: 5},
+			want: 40 * Meter,
+		},
+		{
+			name: "Car At 100 Kilometers Per Hour",
+			args: args{v: Velocity(100 * 1000.0 / 3600), decel: 7},
+			want: Distance(100*1000.0/3600*100*1000.0/3600/14) * Meter,
+		},
+		{
+			name: "Free Fall Reversed",
+			args: args{v: 9.8, decel: 9.8},
+			want: 4.9 * Meter,
+		},
+		{
+			name: "Negative Velocity",
+			args: args{v: -10, decel: 2},
+			want: 25 * Meter,
+		},
+		{
+			name: "At Rest",
+			args: args{v: 0, decel: 3},
+			want: 0,
+		},
+		{
+			name: "Zero Deceleration",
+			args: args{v: 10, decel: 0},
+			want: Distance(math.Inf(1)),
+		},
+		{
+			name: "Negative Deceleration",
+			args: args{v: 10, decel: -1},
+			want: Distance(math.Inf(1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StoppingDistance(tt.args.v, tt.args.decel)
+			if got != tt.want && math.Abs(float64(got-tt.want)) > float64(Micrometer) {
+				t.Errorf("StoppingDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}